@@ -0,0 +1,44 @@
+package digitalocean
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+const accountPath = "/v2/account"
+
+// account extends godo.Account with the team the token belongs to and the
+// reserved IP limit, which godo does not expose yet.
+type account struct {
+	godo.Account
+	ReservedIPLimit int          `json:"reserved_ip_limit,omitempty"`
+	Team            *accountTeam `json:"team,omitempty"`
+}
+
+// accountTeam is the team the account belongs to.
+type accountTeam struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+type accountRoot struct {
+	Account *account `json:"account"`
+}
+
+// getAccount retrieves the account of the current user or team.
+func getAccount(ctx context.Context, client *godo.Client) (*account, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, accountPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(accountRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Account, resp, nil
+}
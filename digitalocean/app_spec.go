@@ -1,6 +1,7 @@
 package digitalocean
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/digitalocean/godo"
@@ -897,6 +898,50 @@ func flattenAppImageSourceSpec(spec *godo.ImageSourceSpec) []interface{} {
 	return result
 }
 
+// validateAppImageSources checks that the components deployed from an image
+// set registry only when it is required by their registry_type, which cannot
+// be done when planning as it may be computed from other resources.
+func validateAppImageSources(spec *godo.AppSpec) error {
+	if spec == nil {
+		return nil
+	}
+
+	var components []string
+	var images []*godo.ImageSourceSpec
+	for _, service := range spec.Services {
+		components = append(components, "service "+service.Name)
+		images = append(images, service.Image)
+	}
+	for _, worker := range spec.Workers {
+		components = append(components, "worker "+worker.Name)
+		images = append(images, worker.Image)
+	}
+	for _, job := range spec.Jobs {
+		components = append(components, "job "+job.Name)
+		images = append(images, job.Image)
+	}
+
+	for i, image := range images {
+		component := components[i]
+		if image == nil {
+			continue
+		}
+
+		switch image.RegistryType {
+		case godo.ImageSourceSpecRegistryType_DockerHub:
+			if image.Registry == "" {
+				return fmt.Errorf("the image of %s must set registry, the Docker Hub user or organization of %s", component, image.Repository)
+			}
+		case godo.ImageSourceSpecRegistryType_DOCR:
+			if image.Registry != "" {
+				return fmt.Errorf("the image of %s must not set registry, the registry of the account is used with DOCR", component)
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandAppEnvs(config []interface{}) []*godo.AppVariableDefinition {
 	appEnvs := make([]*godo.AppVariableDefinition, 0, len(config))
 
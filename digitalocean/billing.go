@@ -0,0 +1,245 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func billingHistoryEntrySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:        schema.TypeString,
+			Description: "the type of the entry, e.g. Invoice, Payment or Credit",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "description of the entry",
+		},
+		"amount": {
+			Type:        schema.TypeString,
+			Description: "the amount of the entry in US dollars, negative for payments and credits",
+		},
+		"date": {
+			Type:        schema.TypeString,
+			Description: "the date and time of the entry",
+		},
+		"invoice_id": {
+			Type:        schema.TypeString,
+			Description: "the ID of the invoice the entry refers to, if any",
+		},
+		"invoice_uuid": {
+			Type:        schema.TypeString,
+			Description: "the UUID of the invoice the entry refers to, if any",
+		},
+	}
+}
+
+func getDigitalOceanBillingHistory(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	var start, end time.Time
+	if v, ok := extra["start_date"].(string); ok && v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing start_date: %s", err)
+		}
+		start = t
+	}
+	if v, ok := extra["end_date"].(string); ok && v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing end_date: %s", err)
+		}
+		end = t
+	}
+
+	var allEntries []interface{}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		history, resp, err := client.BillingHistory.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving billing history: %s", err)
+		}
+
+		for _, entry := range history.BillingHistory {
+			if billingHistoryEntryInRange(entry, start, end) {
+				allEntries = append(allEntries, entry)
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving billing history: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allEntries, nil
+}
+
+// billingHistoryEntryInRange reports whether the entry was made at or after
+// start and before end. A zero start or end leaves the range open on that
+// side.
+func billingHistoryEntryInRange(entry godo.BillingHistoryEntry, start, end time.Time) bool {
+	if !start.IsZero() && entry.Date.Before(start) {
+		return false
+	}
+
+	if !end.IsZero() && !entry.Date.Before(end) {
+		return false
+	}
+
+	return true
+}
+
+func flattenDigitalOceanBillingHistoryEntry(rawEntry, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	entry, ok := rawEntry.(godo.BillingHistoryEntry)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert to godo.BillingHistoryEntry")
+	}
+
+	flattenedEntry := map[string]interface{}{
+		"type":         entry.Type,
+		"description":  entry.Description,
+		"amount":       entry.Amount,
+		"date":         entry.Date.UTC().Format(time.RFC3339),
+		"invoice_id":   "",
+		"invoice_uuid": "",
+	}
+
+	if entry.InvoiceID != nil {
+		flattenedEntry["invoice_id"] = *entry.InvoiceID
+	}
+
+	if entry.InvoiceUUID != nil {
+		flattenedEntry["invoice_uuid"] = *entry.InvoiceUUID
+	}
+
+	return flattenedEntry, nil
+}
+
+const invoicePath = "/v2/customers/my/invoices/%s"
+
+// findInvoice looks up an invoice of the account by its UUID or by its
+// billing period, e.g. 2021-06. The preview of the invoice of the current
+// billing period is included.
+func findInvoice(client *godo.Client, uuid, period string) (*godo.InvoiceListItem, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		list, resp, err := client.Invoices.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoices: %s", err)
+		}
+
+		invoices := list.Invoices
+		if opts.Page == 1 && list.InvoicePreview.InvoiceUUID != "" {
+			invoices = append(invoices, list.InvoicePreview)
+		}
+
+		for _, invoice := range invoices {
+			if (uuid != "" && invoice.InvoiceUUID == uuid) || (period != "" && invoice.InvoicePeriod == period) {
+				return &invoice, nil
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoices: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	if uuid != "" {
+		return nil, fmt.Errorf("Invoice %s not found", uuid)
+	}
+
+	return nil, fmt.Errorf("Invoice for period %s not found", period)
+}
+
+// listInvoiceItems retrieves all of the line items of an invoice.
+func listInvoiceItems(client *godo.Client, uuid string) ([]godo.InvoiceItem, error) {
+	var items []godo.InvoiceItem
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		invoice, resp, err := client.Invoices.Get(context.Background(), uuid, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoice %s: %s", uuid, err)
+		}
+
+		items = append(items, invoice.InvoiceItems...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoice %s: %s", uuid, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return items, nil
+}
+
+// invoiceDownloadURL returns the API URL from which an invoice can be
+// downloaded in the given format, csv or pdf. The request must be
+// authenticated with an API token.
+func invoiceDownloadURL(client *godo.Client, uuid, format string) string {
+	return client.BaseURL.ResolveReference(&url.URL{
+		Path: fmt.Sprintf(invoicePath, uuid) + "/" + format,
+	}).String()
+}
+
+func flattenInvoiceItems(items []godo.InvoiceItem) []interface{} {
+	result := make([]interface{}, 0, len(items))
+
+	for _, item := range items {
+		result = append(result, map[string]interface{}{
+			"product":           item.Product,
+			"resource_id":       item.ResourceID,
+			"resource_uuid":     item.ResourceUUID,
+			"group_description": item.GroupDescription,
+			"description":       item.Description,
+			"amount":            item.Amount,
+			"duration":          item.Duration,
+			"duration_unit":     item.DurationUnit,
+			"start_time":        item.StartTime.UTC().Format(time.RFC3339),
+			"end_time":          item.EndTime.UTC().Format(time.RFC3339),
+			"project_name":      item.ProjectName,
+			"category":          item.Category,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,171 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+const (
+	byoipPrefixesBasePath      = "/v2/byoip_prefixes"
+	byoipPrefixPath            = byoipPrefixesBasePath + "/%s"
+	byoipPrefixAssignmentsPath = byoipPrefixesBasePath + "/%s/ip_assignments"
+	byoipPrefixAssignmentPath  = byoipPrefixesBasePath + "/%s/ip_assignments/%s"
+
+	byoipPrefixStatusActive = "active"
+	byoipPrefixStatusFailed = "failed"
+)
+
+// byoipPrefix is an IP range owned by the customer which DigitalOcean
+// advertises on their behalf once its ownership has been validated.
+type byoipPrefix struct {
+	UUID          string `json:"uuid"`
+	Prefix        string `json:"prefix"`
+	Region        string `json:"region"`
+	Status        string `json:"status"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	Advertised    bool   `json:"advertised"`
+}
+
+type byoipPrefixCreateRequest struct {
+	Prefix    string `json:"prefix"`
+	Signature string `json:"signature"`
+	Region    string `json:"region"`
+}
+
+type byoipPrefixUpdateRequest struct {
+	Advertise bool `json:"advertise"`
+}
+
+type byoipPrefixRoot struct {
+	BYOIPPrefix *byoipPrefix `json:"byoip_prefix"`
+}
+
+// byoipAddressAssignment assigns a single address of a BYOIP prefix to a
+// Droplet.
+type byoipAddressAssignment struct {
+	ID        string `json:"id"`
+	IPAddress string `json:"ip_address"`
+	DropletID int    `json:"droplet_id"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+}
+
+type byoipAddressAssignmentCreateRequest struct {
+	IPAddress string `json:"ip_address"`
+	DropletID int    `json:"droplet_id"`
+}
+
+type byoipAddressAssignmentRoot struct {
+	IPAssignment *byoipAddressAssignment `json:"ip_assignment"`
+}
+
+// createBYOIPPrefix submits a prefix for ownership validation.
+func createBYOIPPrefix(ctx context.Context, client *godo.Client, createRequest *byoipPrefixCreateRequest) (*byoipPrefix, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodPost, byoipPrefixesBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(byoipPrefixRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.BYOIPPrefix, resp, nil
+}
+
+// getBYOIPPrefix retrieves a BYOIP prefix including its validation status.
+func getBYOIPPrefix(ctx context.Context, client *godo.Client, uuid string) (*byoipPrefix, *godo.Response, error) {
+	path := fmt.Sprintf(byoipPrefixPath, uuid)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(byoipPrefixRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.BYOIPPrefix, resp, nil
+}
+
+// updateBYOIPPrefix starts or stops advertising a BYOIP prefix.
+func updateBYOIPPrefix(ctx context.Context, client *godo.Client, uuid string, updateRequest *byoipPrefixUpdateRequest) (*byoipPrefix, *godo.Response, error) {
+	path := fmt.Sprintf(byoipPrefixPath, uuid)
+	req, err := client.NewRequest(ctx, http.MethodPatch, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(byoipPrefixRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.BYOIPPrefix, resp, nil
+}
+
+// deleteBYOIPPrefix removes a BYOIP prefix from the account.
+func deleteBYOIPPrefix(ctx context.Context, client *godo.Client, uuid string) (*godo.Response, error) {
+	path := fmt.Sprintf(byoipPrefixPath, uuid)
+	req, err := client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// createBYOIPAddressAssignment assigns an address of a BYOIP prefix to a
+// Droplet.
+func createBYOIPAddressAssignment(ctx context.Context, client *godo.Client, prefixUUID string, createRequest *byoipAddressAssignmentCreateRequest) (*byoipAddressAssignment, *godo.Response, error) {
+	path := fmt.Sprintf(byoipPrefixAssignmentsPath, prefixUUID)
+	req, err := client.NewRequest(ctx, http.MethodPost, path, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(byoipAddressAssignmentRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.IPAssignment, resp, nil
+}
+
+// getBYOIPAddressAssignment retrieves an address assignment of a BYOIP prefix.
+func getBYOIPAddressAssignment(ctx context.Context, client *godo.Client, prefixUUID, id string) (*byoipAddressAssignment, *godo.Response, error) {
+	path := fmt.Sprintf(byoipPrefixAssignmentPath, prefixUUID, id)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(byoipAddressAssignmentRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.IPAssignment, resp, nil
+}
+
+// deleteBYOIPAddressAssignment unassigns an address of a BYOIP prefix from its
+// Droplet.
+func deleteBYOIPAddressAssignment(ctx context.Context, client *godo.Client, prefixUUID, id string) (*godo.Response, error) {
+	path := fmt.Sprintf(byoipPrefixAssignmentPath, prefixUUID, id)
+	req, err := client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
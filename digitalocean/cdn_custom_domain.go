@@ -0,0 +1,141 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// cdnCertificateDiscardTimeout bounds the deletion of a certificate created
+// for a custom domain the CDN endpoint ended up not using.
+const cdnCertificateDiscardTimeout = 2 * time.Minute
+
+// cdnManagedDomain is the Let's Encrypt certificate and the CNAME record
+// created for the custom domain of a CDN endpoint when manage_custom_domain
+// is set.
+type cdnManagedDomain struct {
+	zone            string
+	recordID        int
+	certificateName string
+}
+
+// createCDNManagedCertificate creates a Let's Encrypt certificate for the
+// custom domain of a CDN endpoint, and waits at most timeout for it to be
+// issued. The custom domain must be a subdomain of a domain managed by
+// DigitalOcean DNS, which is returned along with the certificate.
+func createCDNManagedCertificate(ctx context.Context, meta interface{}, domain string, timeout time.Duration) (*godo.Certificate, string, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	zone, err := findDomainZone(ctx, client, domain)
+	if err != nil {
+		return nil, "", err
+	}
+	if zone == "" {
+		return nil, "", fmt.Errorf("custom_domain %s is not managed by DigitalOcean DNS, add it or its parent domain with the digitalocean_domain resource", domain)
+	}
+	if zone == domain {
+		return nil, "", fmt.Errorf("custom_domain %s must be a subdomain of %s, CNAME records cannot be added to the domain itself", domain, zone)
+	}
+
+	req := &godo.CertificateRequest{
+		Name:     fmt.Sprintf("cdn-%s-%d", strings.ReplaceAll(domain, ".", "-"), time.Now().Unix()),
+		Type:     "lets_encrypt",
+		DNSNames: []string{domain},
+	}
+
+	log.Printf("[INFO] Creating Let's Encrypt certificate %s for CDN custom domain %s", req.Name, domain)
+	cert, err := createCertificateAndWait(ctx, meta, req, timeout)
+	if err != nil {
+		// The certificate may have been created, it is not tracked in the
+		// state yet.
+		err = fmt.Errorf("Error creating certificate for custom_domain %s: %s", domain, err)
+		return nil, "", (&cdnManagedDomain{certificateName: req.Name}).discard(client, err)
+	}
+
+	return cert, zone, nil
+}
+
+// createCDNCustomDomainRecord points the custom domain of a CDN endpoint to
+// the endpoint with a CNAME record in zone, and returns the ID of the record.
+func createCDNCustomDomainRecord(ctx context.Context, client *godo.Client, zone, domain, endpoint string) (int, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	req := &godo.DomainRecordEditRequest{
+		Type: "CNAME",
+		Name: strings.TrimSuffix(domain, "."+zone),
+		Data: endpoint + ".",
+		TTL:  3600,
+	}
+
+	log.Printf("[INFO] Creating CNAME record %s.%s for CDN endpoint %s", req.Name, zone, endpoint)
+	record, _, err := client.Domains.CreateRecord(ctx, zone, req)
+	if err != nil {
+		return 0, fmt.Errorf("Error creating CNAME record for custom_domain %s: %s", domain, err)
+	}
+
+	return record.ID, nil
+}
+
+// delete deletes the CNAME record and the certificate of a managed custom
+// domain. The certificate is deleted once no CDN endpoint uses it anymore,
+// which can take a moment after the endpoint changed, so it is retried for at
+// most timeout.
+func (m *cdnManagedDomain) delete(ctx context.Context, client *godo.Client, timeout time.Duration) error {
+	if m.zone != "" && m.recordID != 0 {
+		log.Printf("[INFO] Deleting CNAME record %d of CDN custom domain from %s", m.recordID, m.zone)
+		resp, err := client.Domains.DeleteRecord(ctx, m.zone, m.recordID)
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+			return fmt.Errorf("Error deleting CNAME record %d from %s: %s", m.recordID, m.zone, err)
+		}
+	}
+
+	if m.certificateName == "" {
+		return nil
+	}
+
+	cert, err := findCertificateByName(client, m.certificateName)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return err
+	}
+
+	log.Printf("[INFO] Deleting certificate %s of CDN custom domain", m.certificateName)
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		resp, err := client.Certificates.Delete(ctx, cert.ID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			if (resp != nil && resp.StatusCode == http.StatusForbidden) || strings.Contains(strings.ToLower(err.Error()), "in use") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error deleting certificate %s: %s", m.certificateName, err))
+		}
+		return nil
+	})
+}
+
+// discard deletes the certificate of a managed custom domain after err
+// prevented the CDN endpoint from using it, so that it is not left behind
+// untracked. It returns err, along with the error deleting the certificate
+// if any. The context of the operation may have expired, so the certificate
+// is deleted with a context of its own.
+func (m *cdnManagedDomain) discard(client *godo.Client, err error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cdnCertificateDiscardTimeout)
+	defer cancel()
+
+	if deleteErr := m.delete(ctx, client, cdnCertificateDiscardTimeout); deleteErr != nil {
+		return fmt.Errorf("%s, and certificate %s could not be deleted, delete it manually: %s", err, m.certificateName, deleteErr)
+	}
+
+	return err
+}
@@ -0,0 +1,353 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func certificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the certificate",
+		},
+		"uuid": {
+			Type:        schema.TypeString,
+			Description: "uuid of the certificate",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "type of the certificate",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Description: "current state of the certificate",
+		},
+		"domains": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "domains for which the certificate was issued",
+		},
+		"not_after": {
+			Type:        schema.TypeString,
+			Description: "expiration date and time of the certificate",
+		},
+		"sha1_fingerprint": {
+			Type:        schema.TypeString,
+			Description: "SHA1 fingerprint of the certificate",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "creation date and time of the certificate",
+		},
+	}
+}
+
+func getDigitalOceanCertificates(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	certs, err := listAllPages(context.Background(), func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		certs, resp, err := client.Certificates.List(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		items := make([]interface{}, len(certs))
+		for i, cert := range certs {
+			items[i] = cert
+		}
+		return items, resp, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving certificates: %s", err)
+	}
+
+	domain, _ := extra["domain"].(string)
+	if domain == "" {
+		return certs, nil
+	}
+
+	var matching []interface{}
+	for _, item := range certs {
+		if certificateCoversDomain(item.(godo.Certificate).DNSNames, domain) {
+			matching = append(matching, item)
+		}
+	}
+
+	return matching, nil
+}
+
+func flattenDigitalOceanCertificate(rawCert, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	cert := rawCert.(godo.Certificate)
+
+	domains := schema.NewSet(schema.HashString, []interface{}{})
+	for _, domain := range cert.DNSNames {
+		domains.Add(domain)
+	}
+
+	flattenedCert := map[string]interface{}{
+		"name":             cert.Name,
+		"uuid":             cert.ID,
+		"type":             cert.Type,
+		"state":            cert.State,
+		"domains":          domains,
+		"not_after":        cert.NotAfter,
+		"sha1_fingerprint": cert.SHA1Fingerprint,
+		"created_at":       cert.Created,
+	}
+
+	return flattenedCert, nil
+}
+
+// certificateCoversDomain reports whether a certificate issued for dnsNames
+// is valid for domain, either because it is one of them or because it matches
+// one of their wildcards. Like in TLS, a wildcard only matches a single label,
+// so *.example.com matches www.example.com but not example.com.
+func certificateCoversDomain(dnsNames []string, domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	for _, name := range dnsNames {
+		name = strings.ToLower(strings.TrimSuffix(name, "."))
+		if name == domain {
+			return true
+		}
+
+		if strings.HasPrefix(name, "*.") {
+			if i := strings.Index(domain, "."); i > 0 && domain[i+1:] == name[2:] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// createCertificateAndWait creates a certificate and waits at most timeout
+// for it to be verified.
+func createCertificateAndWait(ctx context.Context, meta interface{}, req *godo.CertificateRequest, timeout time.Duration) (*godo.Certificate, error) {
+	config := meta.(*CombinedConfig)
+	client := config.godoClient()
+
+	cert, _, err := client.Certificates.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Waiting for certificate (%s) to have state 'verified'", cert.Name)
+	_, err = config.poller().waitForState(ctx, timeout, []string{"pending"}, []string{"verified"}, func() (interface{}, string, error) {
+		cert, _, err := client.Certificates.Get(ctx, cert.ID)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving certificate: %s", err)
+		}
+
+		return cert, cert.State, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// swapCertificate makes the load balancers and CDN endpoints using the
+// certificate oldID use the certificate newID instead, and waits at most
+// timeout for each load balancer to apply the change.
+func swapCertificate(ctx context.Context, meta interface{}, oldID, newID string, timeout time.Duration) error {
+	config := meta.(*CombinedConfig)
+	client := config.godoClient()
+
+	lbs, err := listLoadBalancers(ctx, client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving load balancers: %s", err)
+	}
+
+	for _, lb := range lbs {
+		if !replaceLoadBalancerCertificate(lb, oldID, newID) {
+			continue
+		}
+
+		log.Printf("[INFO] Replacing certificate %s with %s on load balancer (%s)", oldID, newID, lb.ID)
+		if _, _, err := updateLoadBalancer(ctx, client, lb.ID, loadBalancerAsRequest(lb)); err != nil {
+			return fmt.Errorf("Error updating load balancer (%s): %s", lb.ID, err)
+		}
+
+		_, err := config.poller().waitForState(ctx, timeout, []string{"new"}, []string{"active"}, loadbalancerStateRefreshFunc(client, lb.ID))
+		if err != nil {
+			return fmt.Errorf("Error waiting for load balancer (%s) to become active: %s", lb.ID, err)
+		}
+	}
+
+	cdns, err := listAllPages(ctx, func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		cdns, resp, err := client.CDNs.List(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		items := make([]interface{}, len(cdns))
+		for i, cdn := range cdns {
+			items[i] = cdn
+		}
+		return items, resp, nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving CDN endpoints: %s", err)
+	}
+
+	for _, item := range cdns {
+		cdn := item.(godo.CDN)
+		if cdn.CertificateID != oldID {
+			continue
+		}
+
+		log.Printf("[INFO] Replacing certificate %s with %s on CDN endpoint (%s)", oldID, newID, cdn.ID)
+		_, _, err := client.CDNs.UpdateCustomDomain(ctx, cdn.ID, &godo.CDNUpdateCustomDomainRequest{
+			CustomDomain:  cdn.CustomDomain,
+			CertificateID: newID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating CDN endpoint (%s): %s", cdn.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// replaceLoadBalancerCertificate replaces the certificate oldID with newID in
+// the forwarding rules and domains of lb, and reports whether lb used it.
+func replaceLoadBalancerCertificate(lb *loadBalancer, oldID, newID string) bool {
+	replaced := false
+
+	for i := range lb.ForwardingRules {
+		rule := &lb.ForwardingRules[i]
+		if rule.CertificateID == oldID {
+			rule.CertificateID = newID
+			replaced = true
+		}
+	}
+
+	for _, domain := range lb.Domains {
+		if domain.CertificateID == oldID {
+			domain.CertificateID = newID
+			replaced = true
+		}
+	}
+
+	return replaced
+}
+
+// letsEncryptDiagnosisTimeout bounds the lookups made to explain why Let's
+// Encrypt did not issue a certificate.
+const letsEncryptDiagnosisTimeout = 30 * time.Second
+
+// lookupNS looks up the name servers a domain is delegated to.
+var lookupNS = net.DefaultResolver.LookupNS
+
+// letsEncryptDNSRequirements describes what prevents Let's Encrypt from
+// issuing a certificate for domains. DigitalOcean can only issue it if every
+// domain belongs to a domain managed by DigitalOcean DNS, which in turn must
+// be delegated to the DigitalOcean name servers. It is called once waiting
+// for the certificate failed, usually because the context of the operation
+// expired, so the lookups use a context of their own.
+func letsEncryptDNSRequirements(meta interface{}, domains []string) string {
+	client := meta.(*CombinedConfig).godoClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), letsEncryptDiagnosisTimeout)
+	defer cancel()
+
+	var requirements []string
+	for _, domain := range domains {
+		zone, err := findDomainZone(ctx, client, domain)
+		if err != nil {
+			log.Printf("[WARN] %s", err)
+			requirements = append(requirements, fmt.Sprintf("- %s could not be checked: %s", domain, err))
+			continue
+		}
+
+		var nameServers []string
+		if zone != "" {
+			records, err := lookupNS(ctx, zone)
+			if err != nil {
+				log.Printf("[WARN] Error looking up the name servers of %s: %s", zone, err)
+			}
+			for _, record := range records {
+				nameServers = append(nameServers, record.Host)
+			}
+		}
+
+		if requirement := letsEncryptDNSRequirement(domain, zone, nameServers); requirement != "" {
+			requirements = append(requirements, requirement)
+		}
+	}
+
+	if len(requirements) == 0 {
+		return "The domains are managed by DigitalOcean DNS and delegated to its name servers. " +
+			"Let's Encrypt may still be verifying them, try again later or with a longer create timeout."
+	}
+
+	return "Let's Encrypt certificates can only be issued for domains managed by DigitalOcean DNS:\n\n" +
+		strings.Join(requirements, "\n")
+}
+
+// letsEncryptDNSRequirement describes what is missing for Let's Encrypt to
+// issue a certificate for domain, given the domain managed by DigitalOcean DNS
+// it belongs to and the name servers it is delegated to. It returns an empty
+// string when nothing is known to be missing.
+func letsEncryptDNSRequirement(domain, zone string, nameServers []string) string {
+	if zone == "" {
+		return fmt.Sprintf("- %s is not managed by DigitalOcean DNS, add it or its parent domain with the digitalocean_domain resource.", domain)
+	}
+
+	// The name servers could not be looked up, e.g. because the domain was
+	// just added.
+	if len(nameServers) == 0 {
+		return ""
+	}
+
+	for _, ns := range nameServers {
+		if strings.HasSuffix(strings.ToLower(strings.TrimSuffix(ns, ".")), ".digitalocean.com") {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("- %s is delegated to %s, point its NS records to ns1.digitalocean.com, ns2.digitalocean.com and ns3.digitalocean.com at the registrar.",
+		zone, strings.Join(nameServers, ", "))
+}
+
+// findDomainZone returns the domain managed by DigitalOcean DNS that holds the
+// records of domain, or an empty string if there is none.
+func findDomainZone(ctx context.Context, client *godo.Client, domain string) (string, error) {
+	for _, candidate := range certificateDomainZones(domain) {
+		_, resp, err := client.Domains.Get(ctx, candidate)
+		if err == nil {
+			return candidate, nil
+		}
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return "", fmt.Errorf("Error retrieving domain %s: %s", candidate, err)
+		}
+	}
+
+	return "", nil
+}
+
+// certificateDomainZones returns the domains that can hold the records of a
+// domain a certificate is issued for, from the most to the least specific.
+// The top-level domain is left out.
+func certificateDomainZones(domain string) []string {
+	domain = strings.TrimPrefix(strings.TrimSuffix(domain, "."), "*.")
+
+	var zones []string
+	for strings.Contains(domain, ".") {
+		zones = append(zones, domain)
+		domain = domain[strings.Index(domain, ".")+1:]
+	}
+
+	return zones
+}
@@ -2,8 +2,12 @@ package digitalocean
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"net/url"
 	"strings"
 	"text/template"
@@ -11,31 +15,95 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"golang.org/x/oauth2"
 )
 
 type Config struct {
 	Token             string
+	TokenFile         string
+	TokenCommand      string
+	TokenCacheTTL     time.Duration
 	APIEndpoint       string
 	SpacesAPIEndpoint string
+	SpacesRegion      string
 	AccessID          string
 	SecretKey         string
 	TerraformVersion  string
+	UserAgentSuffix   string
+	RequestsPerSecond float64
+	HTTPRetryMax      int
+	HTTPRetryWaitMin  float64
+	HTTPRetryWaitMax  float64
+	HTTPRetryBackoff  string
+	PollInterval      float64
+	PollIntervalMax   float64
+
+	InsecureSkipVerify bool
+	CACertFile         string
+	HTTPDebugLog       bool
+
+	// wrapTransport wraps the transport shared by the API and Spaces clients,
+	// the acceptance tests use it to record and replay the requests.
+	wrapTransport func(http.RoundTripper) http.RoundTripper
 }
 
 type CombinedConfig struct {
 	client                 *godo.Client
 	spacesEndpointTemplate *template.Template
+	spacesDefaultRegion    string
 	accessID               string
 	secretKey              string
+	spacesHTTPClient       *http.Client
+	spacesMaxRetries       int
+	userAgent              string
+	statePoller            *poller
+	projects               *projectResourcesCache
 }
 
 func (c *CombinedConfig) godoClient() *godo.Client { return c.client }
 
+// projectResources returns the cache of the resources assigned to projects.
+func (c *CombinedConfig) projectResources() *projectResourcesCache {
+	if c.projects == nil {
+		return &projectResourcesCache{}
+	}
+	return c.projects
+}
+
+// poller returns the poller used to wait for the resources being changed.
+func (c *CombinedConfig) poller() *poller {
+	if c.statePoller == nil {
+		return defaultPoller
+	}
+	return c.statePoller
+}
+
+// httpClient returns the client used to send requests outside of the API and
+// Spaces, configured with the TLS settings of the provider.
+func (c *CombinedConfig) httpClient() *http.Client {
+	if c.spacesHTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.spacesHTTPClient
+}
+
+// spacesRegion returns region, or the spaces_default_region of the provider
+// if it is empty.
+func (c *CombinedConfig) spacesRegion(region string) (string, error) {
+	if region == "" {
+		region = c.spacesDefaultRegion
+	}
+	if region == "" {
+		return "", fmt.Errorf("region must be set when spaces_default_region is not set in the provider")
+	}
+
+	return strings.ToLower(region), nil
+}
+
 func (c *CombinedConfig) spacesClient(region string) (*session.Session, error) {
 	if c.accessID == "" || c.secretKey == "" {
 		err := fmt.Errorf("Spaces credentials not configured")
@@ -54,31 +122,72 @@ func (c *CombinedConfig) spacesClient(region string) (*session.Session, error) {
 	client, err := session.NewSession(&aws.Config{
 		Region:      aws.String("us-east-1"),
 		Credentials: credentials.NewStaticCredentials(c.accessID, c.secretKey, ""),
-		Endpoint:    aws.String(endpoint)},
-	)
+		Endpoint:    aws.String(endpoint),
+		HTTPClient:  c.spacesHTTPClient,
+		// The AWS SDK retries throttled requests and transient errors
+		// with a jittered backoff by itself.
+		MaxRetries: aws.Int(c.spacesMaxRetries),
+	})
 	if err != nil {
 		return &session.Session{}, err
 	}
+	client.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(c.userAgent))
 
 	return client, nil
 }
 
 // Client() returns a new client for accessing digital ocean.
 func (c *Config) Client() (*CombinedConfig, error) {
-	tokenSrc := oauth2.StaticTokenSource(&oauth2.Token{
-		AccessToken: c.Token,
-	})
+	tokenSrc := c.newTokenSource()
+
+	tlsTransport, err := c.transport()
+	if err != nil {
+		return nil, err
+	}
+
+	var transport http.RoundTripper = tlsTransport
+	if c.wrapTransport != nil {
+		transport = c.wrapTransport(transport)
+	}
+
+	// The requests are logged once authenticated, after the redaction of
+	// their credentials.
+	var apiTransport, spacesTransport http.RoundTripper = transport, transport
+	if c.HTTPDebugLog {
+		apiTransport = newDebugLogTransport("DigitalOcean", transport)
+		spacesTransport = newDebugLogTransport("DigitalOcean Spaces", transport)
+	}
+
+	// The oauth2 client wraps the HTTP client found in the context.
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: apiTransport})
 
 	userAgent := fmt.Sprintf("Terraform/%s", c.TerraformVersion)
-	client := oauth2.NewClient(oauth2.NoContext, tokenSrc)
+	client := oauth2.NewClient(ctx, tokenSrc)
 
-	client.Transport = logging.NewTransport("DigitalOcean", client.Transport)
+	if !c.HTTPDebugLog {
+		client.Transport = logging.NewTransport("DigitalOcean", client.Transport)
+	}
+	client.Transport = newRateLimitTransport(
+		client.Transport,
+		c.RequestsPerSecond,
+		c.HTTPRetryMax,
+		time.Duration(c.HTTPRetryWaitMin*float64(time.Second)),
+		time.Duration(c.HTTPRetryWaitMax*float64(time.Second)),
+		c.HTTPRetryBackoff,
+	)
 
 	godoClient, err := godo.New(client, godo.SetUserAgent(userAgent))
 	if err != nil {
 		return nil, err
 	}
 
+	// The suffix identifies who makes the requests, e.g. a team or a CI
+	// pipeline, it comes last so that it is easy to spot.
+	if c.UserAgentSuffix != "" {
+		godoClient.UserAgent = fmt.Sprintf("%s %s", godoClient.UserAgent, c.UserAgentSuffix)
+		userAgent = fmt.Sprintf("%s %s", userAgent, c.UserAgentSuffix)
+	}
+
 	apiURL, err := url.Parse(c.APIEndpoint)
 	if err != nil {
 		return nil, err
@@ -95,18 +204,84 @@ func (c *Config) Client() (*CombinedConfig, error) {
 	return &CombinedConfig{
 		client:                 godoClient,
 		spacesEndpointTemplate: spacesEndpointTemplate,
+		spacesDefaultRegion:    c.SpacesRegion,
 		accessID:               c.AccessID,
 		secretKey:              c.SecretKey,
+		spacesHTTPClient:       &http.Client{Transport: spacesTransport},
+		spacesMaxRetries:       c.HTTPRetryMax,
+		userAgent:              userAgent,
+		statePoller:            c.poller(),
+		projects:               &projectResourcesCache{},
 	}, nil
 }
 
-// waitForAction waits for the action to finish using the resource.StateChangeConf.
-func waitForAction(client *godo.Client, action *godo.Action) error {
+// poller returns the poller configured by poll_interval and
+// poll_interval_max.
+func (c *Config) poller() *poller {
+	if c.PollInterval <= 0 {
+		return defaultPoller
+	}
+
+	maxInterval := defaultPoller.maxInterval
+	if c.PollIntervalMax > 0 {
+		maxInterval = time.Duration(c.PollIntervalMax * float64(time.Second))
+	}
+
+	return newPoller(time.Duration(c.PollInterval*float64(time.Second)), maxInterval)
+}
+
+// transport returns the HTTP transport shared by the API and Spaces clients,
+// trusting the certificates of ca_cert_file and skipping the verification of
+// certificates if requested, e.g. to test against a mock server or to go
+// through a private gateway.
+func (c *Config) transport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !c.InsecureSkipVerify && c.CACertFile == "" {
+		return transport, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if c.CACertFile != "" {
+		pem, err := ioutil.ReadFile(c.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_cert_file: %s", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM encoded certificate found in ca_cert_file %s", c.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.InsecureSkipVerify {
+		log.Printf("[WARN] TLS certificates of the DigitalOcean API and Spaces are not verified")
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// waitForAction waits at most an hour for the action to finish.
+func waitForAction(ctx context.Context, meta interface{}, action *godo.Action) error {
+	return waitForActionWithTimeout(ctx, meta, action, 60*time.Minute)
+}
+
+// waitForActionWithTimeout waits at most timeout for the action to finish.
+func waitForActionWithTimeout(ctx context.Context, meta interface{}, action *godo.Action, timeout time.Duration) error {
+	config := meta.(*CombinedConfig)
+
 	var (
 		pending   = "in-progress"
 		target    = "completed"
 		refreshfn = func() (result interface{}, state string, err error) {
-			a, _, err := client.Actions.Get(context.Background(), action.ID)
+			a, _, err := config.godoClient().Actions.Get(ctx, action.ID)
 			if err != nil {
 				return nil, "", err
 			}
@@ -119,20 +294,8 @@ func waitForAction(client *godo.Client, action *godo.Action) error {
 			return a, pending, nil
 		}
 	)
-	_, err := (&resource.StateChangeConf{
-		Pending: []string{pending},
-		Refresh: refreshfn,
-		Target:  []string{target},
-
-		Delay:      10 * time.Second,
-		Timeout:    60 * time.Minute,
-		MinTimeout: 3 * time.Second,
-
-		// This is a hack around DO API strangeness.
-		// https://github.com/hashicorp/terraform/issues/481
-		//
-		NotFoundChecks: 60,
-	}).WaitForState()
+
+	_, err := config.poller().waitForState(ctx, timeout, []string{pending}, []string{target}, refreshfn)
 	return err
 }
 
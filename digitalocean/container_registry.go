@@ -0,0 +1,64 @@
+package digitalocean
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+const containerRegistryPath = "/v2/registry"
+
+// containerRegistryRegions are the regions in which a container registry can
+// be created.
+var containerRegistryRegions = []string{"nyc3", "sfo3", "ams3", "sgp1", "fra1"}
+
+// containerRegistry extends godo.Registry with the region of the registry,
+// which is not exposed by godo yet.
+type containerRegistry struct {
+	godo.Registry
+	Region string `json:"region,omitempty"`
+}
+
+type containerRegistryCreateRequest struct {
+	godo.RegistryCreateRequest
+	Region string `json:"region,omitempty"`
+}
+
+type containerRegistryRoot struct {
+	Registry *containerRegistry `json:"registry,omitempty"`
+}
+
+// createContainerRegistry creates the registry of the account in the
+// requested region, the default region of the API is used if none is set.
+func createContainerRegistry(ctx context.Context, client *godo.Client, createRequest *containerRegistryCreateRequest) (*containerRegistry, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodPost, containerRegistryPath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(containerRegistryRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Registry, resp, nil
+}
+
+// getContainerRegistry retrieves the registry of the account including its
+// region.
+func getContainerRegistry(ctx context.Context, client *godo.Client) (*containerRegistry, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, containerRegistryPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(containerRegistryRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Registry, resp, nil
+}
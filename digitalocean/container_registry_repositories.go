@@ -0,0 +1,218 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func containerRegistryRepositorySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the repository",
+		},
+		"tag_count": {
+			Type:        schema.TypeInt,
+			Description: "number of tags in the repository",
+		},
+		"latest_tag": {
+			Type:        schema.TypeString,
+			Description: "the most recently updated tag of the repository",
+		},
+		"latest_tag_manifest_digest": {
+			Type:        schema.TypeString,
+			Description: "the manifest digest of the most recently updated tag",
+		},
+		"latest_tag_updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the most recently updated tag was updated",
+		},
+	}
+}
+
+func containerRegistryRepositoryTagSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"tag": {
+			Type:        schema.TypeString,
+			Description: "name of the tag",
+		},
+		"manifest_digest": {
+			Type:        schema.TypeString,
+			Description: "the digest of the manifest the tag points to",
+		},
+		"compressed_size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "compressed size of the image in bytes",
+		},
+		"size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "uncompressed size of the image in bytes",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the tag was last updated",
+		},
+	}
+}
+
+func getDigitalOceanContainerRegistryRepositories(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	registryName, ok := extra["registry_name"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `registry_name` key from query data")
+	}
+
+	var allRepositories []interface{}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		repositories, resp, err := client.Registry.ListRepositories(context.Background(), registryName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repositories: %s", err)
+		}
+
+		for _, repository := range repositories {
+			allRepositories = append(allRepositories, repository)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repositories: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allRepositories, nil
+}
+
+func flattenDigitalOceanContainerRegistryRepository(rawRepository interface{}, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	repository, ok := rawRepository.(*godo.Repository)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert to *godo.Repository")
+	}
+
+	flattenedRepository := map[string]interface{}{
+		"name":                       repository.Name,
+		"tag_count":                  int(repository.TagCount),
+		"latest_tag":                 "",
+		"latest_tag_manifest_digest": "",
+		"latest_tag_updated_at":      "",
+	}
+
+	if tag := repository.LatestTag; tag != nil {
+		flattenedRepository["latest_tag"] = tag.Tag
+		flattenedRepository["latest_tag_manifest_digest"] = tag.ManifestDigest
+		flattenedRepository["latest_tag_updated_at"] = tag.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
+	return flattenedRepository, nil
+}
+
+func getDigitalOceanContainerRegistryRepositoryTags(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	registryName, ok := extra["registry_name"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `registry_name` key from query data")
+	}
+
+	repositoryName, ok := extra["repository"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `repository` key from query data")
+	}
+
+	var allTags []interface{}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		tags, resp, err := client.Registry.ListRepositoryTags(context.Background(), registryName, repositoryName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repository tags: %s", err)
+		}
+
+		for _, tag := range tags {
+			allTags = append(allTags, tag)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving container registry repository tags: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allTags, nil
+}
+
+func flattenDigitalOceanContainerRegistryRepositoryTag(rawTag interface{}, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	tag, ok := rawTag.(*godo.RepositoryTag)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert to *godo.RepositoryTag")
+	}
+
+	flattenedTag := map[string]interface{}{
+		"tag":                   tag.Tag,
+		"manifest_digest":       tag.ManifestDigest,
+		"compressed_size_bytes": int(tag.CompressedSizeBytes),
+		"size_bytes":            int(tag.SizeBytes),
+		"updated_at":            tag.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	return flattenedTag, nil
+}
+
+// findContainerRegistryRepositoryTag returns the tag of a repository with the
+// given name, or nil if the repository has no such tag.
+func findContainerRegistryRepositoryTag(client *godo.Client, registryName, repository, name string) (*godo.RepositoryTag, *godo.Response, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		tags, resp, err := client.Registry.ListRepositoryTags(context.Background(), registryName, repository, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, tag := range tags {
+			if tag.Tag == name {
+				return tag, resp, nil
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			return nil, resp, nil
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, resp, err
+		}
+
+		opts.Page = page + 1
+	}
+}
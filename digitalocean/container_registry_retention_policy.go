@@ -0,0 +1,140 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	containerRegistryManifestsPath = "/v2/registry/%s/repositoriesV2/%s/digests"
+
+	garbageCollectionStatusRunning   = "running"
+	garbageCollectionStatusSucceeded = "succeeded"
+	garbageCollectionStatusFailed    = "failed"
+	garbageCollectionStatusCancelled = "cancelled"
+)
+
+// containerRegistryManifest is an image manifest of a repository along with
+// the tags pointing to it, which godo does not list yet.
+type containerRegistryManifest struct {
+	Digest              string    `json:"digest"`
+	CompressedSizeBytes uint64    `json:"compressed_size_bytes"`
+	SizeBytes           uint64    `json:"size_bytes"`
+	UpdatedAt           time.Time `json:"updated_at"`
+	Tags                []string  `json:"tags"`
+}
+
+type containerRegistryManifestsRoot struct {
+	Manifests []*containerRegistryManifest `json:"manifests"`
+	Links     *godo.Links                  `json:"links,omitempty"`
+}
+
+// listContainerRegistryManifests retrieves all of the manifests of a
+// repository.
+func listContainerRegistryManifests(ctx context.Context, client *godo.Client, registryName, repository string) ([]*containerRegistryManifest, error) {
+	var manifests []*containerRegistryManifest
+
+	page := 1
+	for {
+		path := fmt.Sprintf(containerRegistryManifestsPath+"?page=%d&per_page=200", registryName, url.PathEscape(repository), page)
+		req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		root := new(containerRegistryManifestsRoot)
+		if _, err := client.Do(ctx, req, root); err != nil {
+			return nil, err
+		}
+
+		manifests = append(manifests, root.Manifests...)
+
+		if root.Links == nil || root.Links.IsLastPage() {
+			break
+		}
+
+		current, err := root.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+
+		page = current + 1
+	}
+
+	return manifests, nil
+}
+
+// containerRegistryTagsToPrune returns the tags of a repository which are not
+// among the keep most recently updated ones.
+func containerRegistryTagsToPrune(tags []*godo.RepositoryTag, keep int) []*godo.RepositoryTag {
+	if len(tags) <= keep {
+		return nil
+	}
+
+	sorted := make([]*godo.RepositoryTag, len(tags))
+	copy(sorted, tags)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].UpdatedAt.After(sorted[j].UpdatedAt)
+	})
+
+	return sorted[keep:]
+}
+
+// containerRegistryManifestsToPrune returns the untagged manifests which were
+// last updated before now minus maxAge.
+func containerRegistryManifestsToPrune(manifests []*containerRegistryManifest, maxAge time.Duration, now time.Time) []*containerRegistryManifest {
+	var pruned []*containerRegistryManifest
+
+	for _, manifest := range manifests {
+		if len(manifest.Tags) == 0 && manifest.UpdatedAt.Before(now.Add(-maxAge)) {
+			pruned = append(pruned, manifest)
+		}
+	}
+
+	return pruned
+}
+
+// garbageCollectionStateRefreshFunc returns the status of a garbage
+// collection. Only the active garbage collection of a registry can be
+// retrieved, completed ones are looked up in the history of the registry.
+func garbageCollectionStateRefreshFunc(client *godo.Client, registryName, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gc, resp, err := client.Registry.GetGarbageCollection(context.Background(), registryName)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return nil, "", fmt.Errorf("Error retrieving garbage collection: %s", err)
+		}
+
+		if err == nil && gc.UUID == uuid {
+			return gc, garbageCollectionStatusRunning, nil
+		}
+
+		gcs, _, err := client.Registry.ListGarbageCollections(context.Background(), registryName, &godo.ListOptions{PerPage: 20})
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving garbage collections: %s", err)
+		}
+
+		for _, gc := range gcs {
+			if gc.UUID != uuid {
+				continue
+			}
+
+			switch gc.Status {
+			case garbageCollectionStatusSucceeded:
+				return gc, gc.Status, nil
+			case garbageCollectionStatusFailed, garbageCollectionStatusCancelled:
+				return nil, "", fmt.Errorf("Garbage collection %s of container registry %s %s", uuid, registryName, gc.Status)
+			default:
+				return gc, garbageCollectionStatusRunning, nil
+			}
+		}
+
+		return nil, "", fmt.Errorf("Garbage collection %s of container registry %s not found", uuid, registryName)
+	}
+}
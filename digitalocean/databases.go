@@ -0,0 +1,486 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	databaseBasePath      = "/v2/databases"
+	databaseDBsPath       = databaseBasePath + "/%s/dbs"
+	databaseDBPath        = databaseBasePath + "/%s/dbs/%s"
+	databaseEventsPath    = databaseBasePath + "/%s/events"
+	databaseUpgradePath   = databaseBasePath + "/%s/upgrade"
+	databaseOptionsPath   = databaseBasePath + "/options"
+	databasePoolPath      = databaseBasePath + "/%s/pools/%s"
+	databaseAutoscalePath = databaseBasePath + "/%s/autoscale"
+	databaseUsersPath     = databaseBasePath + "/%s/users"
+	databaseUserPath      = databaseBasePath + "/%s/users/%s"
+	databaseReplicasPath  = databaseBasePath + "/%s/replicas"
+
+	mongoDBUserRoleReadOnly  = "readOnly"
+	mongoDBUserRoleReadWrite = "readWrite"
+	mongoDBUserRoleDBAdmin   = "dbAdmin"
+)
+
+// databaseDB extends godo.DatabaseDB with the MySQL character set and
+// collation settings, which godo does not model.
+type databaseDB struct {
+	Name      string `json:"name"`
+	Charset   string `json:"charset,omitempty"`
+	Collation string `json:"collation,omitempty"`
+}
+
+type databaseDBRoot struct {
+	DB *databaseDB `json:"db"`
+}
+
+// createDatabaseDB creates a database inside of a cluster, passing through the
+// optional charset and collation settings.
+func createDatabaseDB(ctx context.Context, client *godo.Client, clusterID string, db *databaseDB) (*databaseDB, *godo.Response, error) {
+	path := fmt.Sprintf(databaseDBsPath, clusterID)
+	req, err := client.NewRequest(ctx, http.MethodPost, path, db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseDBRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.DB, resp, nil
+}
+
+// getDatabaseDB retrieves a database inside of a cluster including its charset
+// and collation settings.
+func getDatabaseDB(ctx context.Context, client *godo.Client, clusterID, name string) (*databaseDB, *godo.Response, error) {
+	path := fmt.Sprintf(databaseDBPath, clusterID, name)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseDBRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.DB, resp, nil
+}
+
+// databaseEvent is an entry in a database cluster's event log, e.g. a resize,
+// maintenance run, or failover.
+type databaseEvent struct {
+	ID          string `json:"id"`
+	ClusterName string `json:"cluster_name"`
+	EventType   string `json:"event_type"`
+	CreateTime  string `json:"create_time"`
+}
+
+type databaseEventsRoot struct {
+	Events []databaseEvent `json:"events"`
+}
+
+// listDatabaseEvents retrieves the event log for a database cluster. The API
+// returns the full log in a single response.
+func listDatabaseEvents(ctx context.Context, client *godo.Client, clusterID string) ([]databaseEvent, *godo.Response, error) {
+	path := fmt.Sprintf(databaseEventsPath, clusterID)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseEventsRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Events, resp, nil
+}
+
+func databaseEventSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "ID of the event",
+		},
+		"cluster_name": {
+			Type:        schema.TypeString,
+			Description: "name of the database cluster the event occurred on",
+		},
+		"event_type": {
+			Type:        schema.TypeString,
+			Description: "type of the event, e.g. cluster_maintenance_perform",
+		},
+		"create_time": {
+			Type:        schema.TypeString,
+			Description: "the time the event occurred in ISO8601 combined date and time format",
+		},
+	}
+}
+
+func getDigitalOceanDatabaseEvents(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	events, _, err := listDatabaseEvents(context.Background(), client, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving database cluster events: %s", err)
+	}
+
+	allEvents := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		allEvents = append(allEvents, event)
+	}
+
+	return allEvents, nil
+}
+
+func flattenDigitalOceanDatabaseEvent(rawEvent, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	event, ok := rawEvent.(databaseEvent)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert to databaseEvent")
+	}
+
+	flattenedEvent := map[string]interface{}{
+		"id":           event.ID,
+		"cluster_name": event.ClusterName,
+		"event_type":   event.EventType,
+		"create_time":  event.CreateTime,
+	}
+
+	return flattenedEvent, nil
+}
+
+type databaseUpgradeVersionRequest struct {
+	Version string `json:"version"`
+}
+
+// upgradeDatabaseMajorVersion starts an in-place upgrade of a database
+// cluster's engine to the specified version.
+func upgradeDatabaseMajorVersion(ctx context.Context, client *godo.Client, clusterID, version string) (*godo.Response, error) {
+	path := fmt.Sprintf(databaseUpgradePath, clusterID)
+	req, err := client.NewRequest(ctx, http.MethodPut, path, &databaseUpgradeVersionRequest{Version: version})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// databaseEngineOptions lists the versions available for a database engine.
+type databaseEngineOptions struct {
+	Versions []string `json:"versions"`
+}
+
+type databaseOptionsRoot struct {
+	Options map[string]databaseEngineOptions `json:"options"`
+}
+
+// getDatabaseOptions retrieves the options available for each database
+// engine keyed by engine slug.
+func getDatabaseOptions(ctx context.Context, client *godo.Client) (map[string]databaseEngineOptions, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, databaseOptionsPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseOptionsRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Options, resp, nil
+}
+
+// databaseUpdatePoolRequest is used to update an existing connection pool.
+type databaseUpdatePoolRequest struct {
+	User     string `json:"user,omitempty"`
+	Size     int    `json:"size"`
+	Database string `json:"db"`
+	Mode     string `json:"mode"`
+}
+
+// updateDatabasePool updates the size and mode of a connection pool in place.
+func updateDatabasePool(ctx context.Context, client *godo.Client, clusterID, name string, update *databaseUpdatePoolRequest) (*godo.Response, error) {
+	path := fmt.Sprintf(databasePoolPath, clusterID, name)
+	req, err := client.NewRequest(ctx, http.MethodPut, path, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// databaseStorageAutoscale configures automatic growth of a database
+// cluster's disk once its usage passes a threshold.
+type databaseStorageAutoscale struct {
+	Enabled          bool `json:"enabled"`
+	ThresholdPercent int  `json:"threshold_percent,omitempty"`
+	IncrementGiB     int  `json:"increment_gib,omitempty"`
+	MaxSizeGiB       int  `json:"max_size_gib,omitempty"`
+}
+
+type databaseAutoscale struct {
+	Storage *databaseStorageAutoscale `json:"storage"`
+}
+
+type databaseAutoscaleRoot struct {
+	Autoscale *databaseAutoscale `json:"autoscale"`
+}
+
+// getDatabaseAutoscale retrieves the autoscaling configuration for a cluster.
+func getDatabaseAutoscale(ctx context.Context, client *godo.Client, clusterID string) (*databaseAutoscale, *godo.Response, error) {
+	path := fmt.Sprintf(databaseAutoscalePath, clusterID)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseAutoscaleRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Autoscale, resp, nil
+}
+
+// updateDatabaseAutoscale replaces the autoscaling configuration for a cluster.
+func updateDatabaseAutoscale(ctx context.Context, client *godo.Client, clusterID string, autoscale *databaseAutoscale) (*godo.Response, error) {
+	path := fmt.Sprintf(databaseAutoscalePath, clusterID)
+	req, err := client.NewRequest(ctx, http.MethodPut, path, &databaseAutoscaleRoot{Autoscale: autoscale})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// databaseMongoUserSettings scopes a MongoDB user to a built-in role on a set
+// of databases rather than granting it access to the whole cluster.
+type databaseMongoUserSettings struct {
+	Role      string   `json:"role,omitempty"`
+	Databases []string `json:"databases,omitempty"`
+}
+
+type databaseUserSettings struct {
+	MongoUserSettings *databaseMongoUserSettings `json:"mongo_user_settings,omitempty"`
+}
+
+// databaseUser extends godo.DatabaseUser with engine specific settings which
+// godo does not model.
+type databaseUser struct {
+	godo.DatabaseUser
+	Settings *databaseUserSettings `json:"settings,omitempty"`
+}
+
+type databaseCreateUserRequest struct {
+	godo.DatabaseCreateUserRequest
+	Settings *databaseUserSettings `json:"settings,omitempty"`
+}
+
+type databaseUpdateUserRequest struct {
+	Settings *databaseUserSettings `json:"settings"`
+}
+
+type databaseUserRoot struct {
+	User *databaseUser `json:"user"`
+}
+
+// createDatabaseUser creates a user inside of a cluster including its engine
+// specific settings.
+func createDatabaseUser(ctx context.Context, client *godo.Client, clusterID string, createUser *databaseCreateUserRequest) (*databaseUser, *godo.Response, error) {
+	path := fmt.Sprintf(databaseUsersPath, clusterID)
+	req, err := client.NewRequest(ctx, http.MethodPost, path, createUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseUserRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.User, resp, nil
+}
+
+// getDatabaseUser retrieves a user inside of a cluster including its engine
+// specific settings.
+func getDatabaseUser(ctx context.Context, client *godo.Client, clusterID, name string) (*databaseUser, *godo.Response, error) {
+	path := fmt.Sprintf(databaseUserPath, clusterID, name)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseUserRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.User, resp, nil
+}
+
+// updateDatabaseUser updates the engine specific settings of a user.
+func updateDatabaseUser(ctx context.Context, client *godo.Client, clusterID, name string, updateUser *databaseUpdateUserRequest) (*databaseUser, *godo.Response, error) {
+	path := fmt.Sprintf(databaseUserPath, clusterID, name)
+	req, err := client.NewRequest(ctx, http.MethodPut, path, updateUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseUserRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.User, resp, nil
+}
+
+// databaseReplica extends godo.DatabaseReplica with the replica's size slug,
+// which godo does not model.
+type databaseReplica struct {
+	godo.DatabaseReplica
+	Size string `json:"size"`
+}
+
+type databaseReplicasRoot struct {
+	Replicas []databaseReplica `json:"replicas"`
+}
+
+// listDatabaseReplicas retrieves all read-only replicas of a database cluster.
+// The API returns all replicas in a single response.
+func listDatabaseReplicas(ctx context.Context, client *godo.Client, clusterID string) ([]databaseReplica, *godo.Response, error) {
+	path := fmt.Sprintf(databaseReplicasPath, clusterID)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(databaseReplicasRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Replicas, resp, nil
+}
+
+func databaseReplicaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the replica",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "slug of the region the replica is located in",
+		},
+		"size": {
+			Type:        schema.TypeString,
+			Description: "slug of the size of the replica",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "current status of the replica",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the replica was created",
+		},
+		"private_network_uuid": {
+			Type:        schema.TypeString,
+			Description: "ID of the VPC the replica is located in",
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Description: "public hostname of the replica",
+		},
+		"private_host": {
+			Type:        schema.TypeString,
+			Description: "hostname of the replica only accessible from within its VPC",
+		},
+		"port": {
+			Type:        schema.TypeInt,
+			Description: "network port the replica is listening on",
+		},
+		"database": {
+			Type:        schema.TypeString,
+			Description: "name of the replica's default database",
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Description: "username for the replica's default user",
+		},
+		"tags": tagsDataSourceSchema(),
+	}
+}
+
+func getDigitalOceanDatabaseReplicas(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	clusterID, ok := extra["cluster_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `cluster_id` key from query data")
+	}
+
+	replicas, _, err := listDatabaseReplicas(context.Background(), client, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving database replicas: %s", err)
+	}
+
+	allReplicas := make([]interface{}, 0, len(replicas))
+	for _, replica := range replicas {
+		allReplicas = append(allReplicas, replica)
+	}
+
+	return allReplicas, nil
+}
+
+func flattenDigitalOceanDatabaseReplica(rawReplica, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	replica, ok := rawReplica.(databaseReplica)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert to databaseReplica")
+	}
+
+	flattenedReplica := map[string]interface{}{
+		"name":                 replica.Name,
+		"region":               replica.Region,
+		"size":                 replica.Size,
+		"status":               replica.Status,
+		"created_at":           replica.CreatedAt.UTC().String(),
+		"private_network_uuid": replica.PrivateNetworkUUID,
+		"tags":                 flattenTags(replica.Tags),
+	}
+
+	if replica.Connection != nil {
+		flattenedReplica["host"] = replica.Connection.Host
+		flattenedReplica["port"] = replica.Connection.Port
+		flattenedReplica["database"] = replica.Connection.Database
+		flattenedReplica["user"] = replica.Connection.User
+	}
+
+	// The private connection is only returned for replicas in a VPC.
+	if replica.PrivateConnection != nil {
+		flattenedReplica["private_host"] = replica.PrivateConnection.Host
+	}
+
+	return flattenedReplica, nil
+}
@@ -21,6 +21,16 @@ func dataSourceDigitalOceanAccount() *schema.Resource {
 				Computed:    true,
 				Description: "The total number of Floating IPs the current user or team may have.",
 			},
+			"volume_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of Volumes the current user or team may have.",
+			},
+			"reserved_ip_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of Reserved IPs the current user or team may have.",
+			},
 			"email": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -46,6 +56,25 @@ func dataSourceDigitalOceanAccount() *schema.Resource {
 				Computed:    true,
 				Description: "A human-readable message giving more details about the status of the account.",
 			},
+			"team": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The team the account belongs to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique universal identifier for the team.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the team.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -53,7 +82,7 @@ func dataSourceDigitalOceanAccount() *schema.Resource {
 func dataSourceDigitalOceanAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*CombinedConfig).godoClient()
 
-	account, _, err := client.Account.Get(context.Background())
+	account, _, err := getAccount(context.Background(), client)
 	if err != nil {
 		return diag.Errorf("Error retrieving account: %s", err)
 	}
@@ -61,11 +90,36 @@ func dataSourceDigitalOceanAccountRead(ctx context.Context, d *schema.ResourceDa
 	d.SetId(account.UUID)
 	d.Set("droplet_limit", account.DropletLimit)
 	d.Set("floating_ip_limit", account.FloatingIPLimit)
+	d.Set("volume_limit", account.VolumeLimit)
+
+	// Reserved IPs are the renamed Floating IPs, the API may only report
+	// the limit under the former name.
+	reservedIPLimit := account.ReservedIPLimit
+	if reservedIPLimit == 0 {
+		reservedIPLimit = account.FloatingIPLimit
+	}
+	d.Set("reserved_ip_limit", reservedIPLimit)
 	d.Set("email", account.Email)
 	d.Set("uuid", account.UUID)
 	d.Set("email_verified", account.EmailVerified)
 	d.Set("status", account.Status)
 	d.Set("status_message", account.StatusMessage)
+	if err := d.Set("team", flattenAccountTeam(account.Team)); err != nil {
+		return diag.Errorf("Error setting `team`: %+v", err)
+	}
 
 	return nil
 }
+
+func flattenAccountTeam(team *accountTeam) []interface{} {
+	if team == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"uuid": team.UUID,
+			"name": team.Name,
+		},
+	}
+}
@@ -17,6 +17,14 @@ func TestAccDataSourceDigitalOceanAccount_Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(
 						"data.digitalocean_account.foobar", "uuid"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_account.foobar", "droplet_limit"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_account.foobar", "volume_limit"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_account.foobar", "reserved_ip_limit"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_account.foobar", "email_verified"),
 				),
 			},
 		},
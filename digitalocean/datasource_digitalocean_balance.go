@@ -0,0 +1,56 @@
+package digitalocean
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDigitalOceanBalance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanBalanceRead,
+		Schema: map[string]*schema.Schema{
+			"month_to_date_balance": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Balance as of the generated_at time, the account balance plus the month-to-date usage.",
+			},
+			"account_balance": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current balance of the most recent billing activity.",
+			},
+			"month_to_date_usage": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Amount used in the current billing period as of the generated_at time.",
+			},
+			"generated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the balance was generated.",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanBalanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	balance, _, err := client.Balance.Get(context.Background())
+	if err != nil {
+		return diag.Errorf("Error retrieving balance: %s", err)
+	}
+
+	generatedAt := balance.GeneratedAt.UTC().Format(time.RFC3339)
+
+	d.SetId(generatedAt)
+	d.Set("month_to_date_balance", balance.MonthToDateBalance)
+	d.Set("account_balance", balance.AccountBalance)
+	d.Set("month_to_date_usage", balance.MonthToDateUsage)
+	d.Set("generated_at", generatedAt)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package digitalocean
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanBalance_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanBalanceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_balance.foobar", "month_to_date_balance"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_balance.foobar", "account_balance"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_balance.foobar", "month_to_date_usage"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_balance.foobar", "generated_at"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanBalanceConfig_basic = `
+data "digitalocean_balance" "foobar" {
+}`
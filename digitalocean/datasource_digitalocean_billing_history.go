@@ -0,0 +1,32 @@
+package digitalocean
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDigitalOceanBillingHistory() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        billingHistoryEntrySchema(),
+		ResultAttributeName: "billing_history",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "only return the entries made at or after this time",
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "only return the entries made before this time",
+			},
+		},
+		FlattenRecord: flattenDigitalOceanBillingHistoryEntry,
+		GetRecords:    getDigitalOceanBillingHistory,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,65 @@
+package digitalocean
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestBillingHistoryEntryInRange(t *testing.T) {
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		date       time.Time
+		start, end time.Time
+		expected   bool
+	}{
+		{date: start, start: start, end: end, expected: true},
+		{date: start.Add(-time.Second), start: start, end: end, expected: false},
+		{date: end.Add(-time.Second), start: start, end: end, expected: true},
+		{date: end, start: start, end: end, expected: false},
+		{date: start.Add(-time.Hour), end: end, expected: true},
+		{date: end.Add(time.Hour), start: start, expected: true},
+		{date: end.Add(time.Hour), expected: true},
+	}
+
+	for _, c := range cases {
+		entry := godo.BillingHistoryEntry{Date: c.date}
+		if actual := billingHistoryEntryInRange(entry, c.start, c.end); actual != c.expected {
+			t.Errorf("expected %s in [%s, %s) to be %t, got %t", c.date, c.start, c.end, c.expected, actual)
+		}
+	}
+}
+
+func TestAccDataSourceDigitalOceanBillingHistory_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanBillingHistoryConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_billing_history.foobar", "billing_history.#"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_billing_history.future", "billing_history.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanBillingHistoryConfig_basic = `
+data "digitalocean_billing_history" "foobar" {
+  sort {
+    key       = "date"
+    direction = "desc"
+  }
+}
+
+data "digitalocean_billing_history" "future" {
+  start_date = "2100-01-01T00:00:00Z"
+}`
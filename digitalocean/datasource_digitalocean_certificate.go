@@ -97,6 +97,7 @@ func findCertificateByName(client *godo.Client, name string) (*godo.Certificate,
 		PerPage: 200,
 	}
 
+	var found *godo.Certificate
 	for {
 		certs, resp, err := client.Certificates.List(context.Background(), opts)
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
@@ -106,9 +107,9 @@ func findCertificateByName(client *godo.Client, name string) (*godo.Certificate,
 			return nil, fmt.Errorf("Error retrieving certificates: %s", err)
 		}
 
-		for _, cert := range certs {
-			if cert.Name == name {
-				return &cert, nil
+		for i := range certs {
+			if certs[i].Name == name && preferCertificate(&certs[i], found) {
+				found = &certs[i]
 			}
 		}
 
@@ -124,5 +125,25 @@ func findCertificateByName(client *godo.Client, name string) (*godo.Certificate,
 		opts.Page = page + 1
 	}
 
-	return nil, fmt.Errorf("Certificate %s not found", name)
+	if found == nil {
+		return nil, fmt.Errorf("Certificate %s not found", name)
+	}
+
+	return found, nil
+}
+
+// preferCertificate reports whether cert should be used rather than current
+// when both have the same name. This can happen for a moment while a Let's
+// Encrypt certificate is renewed, in which case the verified certificate
+// expiring last is the renewed one.
+func preferCertificate(cert, current *godo.Certificate) bool {
+	if current == nil {
+		return true
+	}
+
+	if (cert.State == "verified") != (current.State == "verified") {
+		return cert.State == "verified"
+	}
+
+	return cert.NotAfter > current.NotAfter
 }
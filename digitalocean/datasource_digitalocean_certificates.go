@@ -0,0 +1,24 @@
+package digitalocean
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDigitalOceanCertificates() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        certificateSchema(),
+		ResultAttributeName: "certificates",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "only return the certificates valid for this domain, directly or through a wildcard",
+			},
+		},
+		GetRecords:    getDigitalOceanCertificates,
+		FlattenRecord: flattenDigitalOceanCertificate,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,104 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanCertificates_Basic(t *testing.T) {
+	prefix := fmt.Sprintf("certificates-%s", acctest.RandString(10))
+
+	privateKeyMaterial, leafCertMaterial, certChainMaterial := generateTestCertMaterial(t)
+	wildcardCertMaterial, wildcardKeyMaterial, err := randTLSCert("Acme Co", "*.example.org")
+	if err != nil {
+		t.Fatalf("Cannot generate test TLS certificate: %s", err)
+	}
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_certificate" "foo" {
+  name              = "%s-foo"
+  private_key       = <<EOF
+%s
+EOF
+  leaf_certificate  = <<EOF
+%s
+EOF
+  certificate_chain = <<EOF
+%s
+EOF
+}
+
+resource "digitalocean_certificate" "bar" {
+  name             = "%s-bar"
+  private_key      = <<EOF
+%s
+EOF
+  leaf_certificate = <<EOF
+%s
+EOF
+}
+`, prefix, privateKeyMaterial, leafCertMaterial, certChainMaterial, prefix, wildcardKeyMaterial, wildcardCertMaterial)
+
+	datasourceConfig := fmt.Sprintf(`
+data "digitalocean_certificates" "result" {
+  domain = "www.example.org"
+
+  filter {
+    key      = "name"
+    values   = ["%s"]
+    match_by = "substring"
+  }
+}
+`, prefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_certificates.result", "certificates.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_certificates.result", "certificates.0.name", "digitalocean_certificate.bar", "name"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_certificates.result", "certificates.0.uuid", "digitalocean_certificate.bar", "uuid"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_certificates.result", "certificates.0.not_after", "digitalocean_certificate.bar", "not_after"),
+					resource.TestCheckResourceAttr("data.digitalocean_certificates.result", "certificates.0.type", "custom"),
+					resource.TestCheckResourceAttr("data.digitalocean_certificates.result", "certificates.0.state", "verified"),
+					resource.TestCheckTypeSetElemAttr("data.digitalocean_certificates.result", "certificates.0.domains.*", "*.example.org"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
+
+func TestCertificateCoversDomain(t *testing.T) {
+	cases := []struct {
+		dnsNames []string
+		domain   string
+		expected bool
+	}{
+		{dnsNames: []string{"example.com"}, domain: "example.com", expected: true},
+		{dnsNames: []string{"example.com"}, domain: "Example.com.", expected: true},
+		{dnsNames: []string{"example.com", "www.example.com"}, domain: "www.example.com", expected: true},
+		{dnsNames: []string{"*.example.com"}, domain: "www.example.com", expected: true},
+		{dnsNames: []string{"*.example.com"}, domain: "example.com", expected: false},
+		{dnsNames: []string{"*.example.com"}, domain: "a.b.example.com", expected: false},
+		{dnsNames: []string{"example.com"}, domain: "example.org", expected: false},
+		{dnsNames: nil, domain: "example.com", expected: false},
+	}
+
+	for _, c := range cases {
+		if actual := certificateCoversDomain(c.dnsNames, c.domain); actual != c.expected {
+			t.Errorf("expected a certificate for %v to cover %s: %t, got %t", c.dnsNames, c.domain, c.expected, actual)
+		}
+	}
+}
@@ -22,6 +22,10 @@ func dataSourceDigitalOceanContainerRegistry() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -30,6 +34,22 @@ func dataSourceDigitalOceanContainerRegistry() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"storage_usage_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"storage_usage_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"included_storage_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"included_bandwidth_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -0,0 +1,25 @@
+package digitalocean
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDigitalOceanContainerRegistryRepositories() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        containerRegistryRepositorySchema(),
+		ResultAttributeName: "repositories",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanContainerRegistryRepository,
+		GetRecords:    getDigitalOceanContainerRegistryRepositories,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,100 @@
+package digitalocean
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestFlattenDigitalOceanContainerRegistryRepository(t *testing.T) {
+	updatedAt := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Input  *godo.Repository
+		Output map[string]interface{}
+	}{
+		{
+			&godo.Repository{
+				RegistryName: "example",
+				Name:         "app",
+				TagCount:     2,
+				LatestTag: &godo.RepositoryTag{
+					Tag:            "v1.2.0",
+					ManifestDigest: "sha256:abc",
+					UpdatedAt:      updatedAt,
+				},
+			},
+			map[string]interface{}{
+				"name":                       "app",
+				"tag_count":                  2,
+				"latest_tag":                 "v1.2.0",
+				"latest_tag_manifest_digest": "sha256:abc",
+				"latest_tag_updated_at":      "2021-06-01T12:00:00Z",
+			},
+		},
+		{
+			&godo.Repository{
+				RegistryName: "example",
+				Name:         "empty",
+			},
+			map[string]interface{}{
+				"name":                       "empty",
+				"tag_count":                  0,
+				"latest_tag":                 "",
+				"latest_tag_manifest_digest": "",
+				"latest_tag_updated_at":      "",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := flattenDigitalOceanContainerRegistryRepository(tc.Input, nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Output) {
+			t.Fatalf("expected:\n%#v\ngot:\n%#v", tc.Output, actual)
+		}
+	}
+}
+
+func TestAccDataSourceDigitalOceanContainerRegistryRepositories_Basic(t *testing.T) {
+	regName := randomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_container_registry" "foo" {
+  name                   = "%s"
+  subscription_tier_slug = "basic"
+}
+`, regName)
+
+	dataSourceConfig := `
+data "digitalocean_container_registry_repositories" "foobar" {
+  registry_name = digitalocean_container_registry.foo.name
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry_repositories.foobar", "registry_name", regName),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry_repositories.foobar", "repositories.#", "0"),
+				),
+			},
+		},
+	})
+}
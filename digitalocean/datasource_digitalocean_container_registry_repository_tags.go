@@ -0,0 +1,30 @@
+package digitalocean
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDigitalOceanContainerRegistryRepositoryTags() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        containerRegistryRepositoryTagSchema(),
+		ResultAttributeName: "tags",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanContainerRegistryRepositoryTag,
+		GetRecords:    getDigitalOceanContainerRegistryRepositoryTags,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
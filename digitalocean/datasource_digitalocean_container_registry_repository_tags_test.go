@@ -0,0 +1,49 @@
+package digitalocean
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Images can not be pushed from the acceptance tests, the tags of an existing
+// repository are listed instead.
+func TestAccDataSourceDigitalOceanContainerRegistryRepositoryTags_Basic(t *testing.T) {
+	registryName := os.Getenv("DIGITALOCEAN_REGISTRY_NAME")
+	repository := os.Getenv("DIGITALOCEAN_REGISTRY_REPOSITORY")
+	if registryName == "" || repository == "" {
+		t.Skip("DIGITALOCEAN_REGISTRY_NAME and DIGITALOCEAN_REGISTRY_REPOSITORY must be set to test repository tags")
+	}
+
+	dataSourceConfig := fmt.Sprintf(`
+data "digitalocean_container_registry_repository_tags" "foobar" {
+  registry_name = "%s"
+  repository    = "%s"
+
+  sort {
+    key       = "updated_at"
+    direction = "desc"
+  }
+}
+`, registryName, repository)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry_repository_tags.foobar", "tags.0.tag"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry_repository_tags.foobar", "tags.0.manifest_digest"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry_repository_tags.foobar", "tags.0.updated_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package digitalocean
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDigitalOceanDatabaseCA() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDatabaseCARead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanDatabaseCARead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+	clusterID := d.Get("cluster_id").(string)
+
+	ca, _, err := client.Databases.GetCA(context.Background(), clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving CA certificate for database cluster: %s", err)
+	}
+
+	d.SetId(clusterID)
+	// The API returns the PEM encoded certificate base64 encoded. It is decoded
+	// when unmarshalled into the []byte field.
+	d.Set("certificate", string(ca.Certificate))
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package digitalocean
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseCA_Basic(t *testing.T) {
+	var database godo.Database
+	databaseName := randomTestName()
+
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDigitalOceanDatasourceDatabaseCAConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("digitalocean_database_cluster.foobar", "id",
+						"data.digitalocean_database_ca.ca", "cluster_id"),
+					resource.TestMatchResourceAttr(
+						"data.digitalocean_database_ca.ca", "certificate", regexp.MustCompile("^-----BEGIN CERTIFICATE-----")),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatasourceDatabaseCAConfig = `
+
+data "digitalocean_database_ca" "ca" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+}`
@@ -0,0 +1,54 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseEvents_Basic(t *testing.T) {
+	var database godo.Database
+	databaseName := randomTestName()
+
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+				),
+			},
+			{
+				Config: databaseConfig + testAccCheckDigitalOceanDatasourceDatabaseEventsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_database_events.foobar", "events.#"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_database_events.foobar", "events.0.cluster_name", databaseName),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_database_events.foobar", "events.0.event_type"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_database_events.foobar", "events.0.create_time"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatasourceDatabaseEventsConfig = `
+
+data "digitalocean_database_events" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+
+  sort {
+    key       = "create_time"
+    direction = "desc"
+  }
+}`
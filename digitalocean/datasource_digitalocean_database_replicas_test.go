@@ -0,0 +1,60 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDatabaseReplicas_Basic(t *testing.T) {
+	var databaseReplica godo.DatabaseReplica
+	var database godo.Database
+
+	databaseName := randomTestName()
+	databaseReplicaName := randomTestName()
+
+	databaseConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigBasic, databaseName)
+	replicaConfig := fmt.Sprintf(testAccCheckDigitalOceanDatabaseReplicaConfigBasic, databaseReplicaName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig + replicaConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					testAccCheckDigitalOceanDatabaseReplicaExists("digitalocean_database_replica.read-01", &databaseReplica),
+				),
+			},
+			{
+				Config: databaseConfig + replicaConfig + testAccCheckDigitalOceanDatasourceDatabaseReplicasConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_database_replicas.foobar", "replicas.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_database_replicas.foobar", "replicas.0.name", databaseReplicaName),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_database_replicas.foobar", "replicas.0.region", "nyc3"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_database_replicas.foobar", "replicas.0.size", "db-s-2vcpu-4gb"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_database_replicas.foobar", "replicas.0.host"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_database_replicas.foobar", "replicas.0.private_host"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_database_replicas.foobar", "replicas.0.port"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDigitalOceanDatasourceDatabaseReplicasConfig = `
+
+data "digitalocean_database_replicas" "foobar" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+}`
@@ -0,0 +1,144 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDigitalOceanDropletBandwidth() *schema.Resource {
+	samplesSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timestamp": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"mbps": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanDropletBandwidthRead,
+		Schema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"interface": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+				Description:  "the network interface of the Droplet",
+			},
+			"window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "24h",
+				ValidateFunc: validateDropletBandwidthWindow,
+				Description:  "the duration of the time frame, ending at end, e.g. 24h",
+			},
+			"end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "the end of the time frame in RFC 3339 format, defaults to now",
+			},
+
+			// computed attributes
+			"start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inbound":  samplesSchema,
+			"outbound": samplesSchema,
+			"inbound_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "estimate of the bytes received during the time frame",
+			},
+			"outbound_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "estimate of the bytes sent during the time frame",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanDropletBandwidthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	dropletID := d.Get("droplet_id").(int)
+	iface := d.Get("interface").(string)
+
+	window, err := time.ParseDuration(d.Get("window").(string))
+	if err != nil {
+		return diag.Errorf("Error parsing window: %s", err)
+	}
+
+	end := time.Now().UTC().Truncate(time.Minute)
+	if v, ok := d.GetOk("end"); ok {
+		end, err = time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("Error parsing end: %s", err)
+		}
+	}
+	start := end.Add(-window)
+
+	for _, direction := range []string{"inbound", "outbound"} {
+		samples, _, err := getDropletBandwidthMetrics(context.Background(), client, dropletID, iface, direction, start, end)
+		if err != nil {
+			return diag.Errorf("Error retrieving %s bandwidth of Droplet %d: %s", direction, dropletID, err)
+		}
+
+		if err := d.Set(direction, flattenDropletBandwidthSamples(samples)); err != nil {
+			return diag.Errorf("[DEBUG] Error setting %s - error: %#v", direction, err)
+		}
+		d.Set(direction+"_bytes", bandwidthTransferredBytes(samples))
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s/%d/%d", dropletID, iface, start.Unix(), end.Unix()))
+	d.Set("start", start.Format(time.RFC3339))
+	d.Set("end", end.Format(time.RFC3339))
+
+	return nil
+}
+
+func flattenDropletBandwidthSamples(samples []metricsSample) []interface{} {
+	flattened := make([]interface{}, 0, len(samples))
+	for _, sample := range samples {
+		flattened = append(flattened, map[string]interface{}{
+			"timestamp": sample.Time.Format(time.RFC3339),
+			"mbps":      sample.Value,
+		})
+	}
+
+	return flattened
+}
+
+func validateDropletBandwidthWindow(v interface{}, k string) ([]string, []error) {
+	window, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a duration such as 24h, got %q: %s", k, v, err)}
+	}
+
+	if window <= 0 {
+		return nil, []error{fmt.Errorf("expected %s to be a positive duration, got %q", k, v)}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,73 @@
+package digitalocean
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletBandwidth_Basic(t *testing.T) {
+	name := randomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foo" {
+  name       = "%s"
+  size       = "s-1vcpu-1gb"
+  image      = "ubuntu-20-04-x64"
+  region     = "nyc3"
+  monitoring = true
+}
+`, name)
+
+	dataSourceConfig := `
+data "digitalocean_droplet_bandwidth" "foobar" {
+  droplet_id = digitalocean_droplet.foo.id
+  window     = "1h"
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_droplet_bandwidth.foobar", "droplet_id", "digitalocean_droplet.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_bandwidth.foobar", "interface", "public"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_bandwidth.foobar", "start"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_bandwidth.foobar", "end"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_bandwidth.foobar", "inbound_bytes"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_bandwidth.foobar", "outbound_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanDropletBandwidth_InvalidWindow(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_droplet_bandwidth" "foobar" {
+  droplet_id = 1
+  window     = "one day"
+}`,
+				ExpectError: regexp.MustCompile("expected window to be a duration such as 24h"),
+			},
+		},
+	})
+}
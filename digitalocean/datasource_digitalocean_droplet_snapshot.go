@@ -78,32 +78,9 @@ func dataSourceDigitalOceanDropletSnapshotRead(ctx context.Context, d *schema.Re
 		return diag.Errorf("One of `name` or `name_regex` must be assigned")
 	}
 
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
-	}
-
-	var snapshotList []godo.Snapshot
-
-	for {
-		snapshots, resp, err := client.Snapshots.ListDroplet(context.Background(), opts)
-
-		if err != nil {
-			return diag.Errorf("Error retrieving Droplet snapshots: %s", err)
-		}
-
-		snapshotList = append(snapshotList, snapshots...)
-
-		if resp.Links == nil || resp.Links.IsLastPage() {
-			break
-		}
-
-		page, err := resp.Links.CurrentPage()
-		if err != nil {
-			return diag.Errorf("Error retrieving Droplet snapshots: %s", err)
-		}
-
-		opts.Page = page + 1
+	snapshotList, err := listDigitalOceanSnapshots(client.Snapshots.ListDroplet)
+	if err != nil {
+		return diag.Errorf("Error retrieving Droplet snapshots: %s", err)
 	}
 
 	// Go through all the possible filters
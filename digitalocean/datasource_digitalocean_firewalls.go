@@ -0,0 +1,17 @@
+package digitalocean
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDigitalOceanFirewalls() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        firewallListSchema(),
+		ResultAttributeName: "firewalls",
+		GetRecords:          getDigitalOceanFirewalls,
+		FlattenRecord:       flattenDigitalOceanFirewall,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,90 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanFirewalls_Basic(t *testing.T) {
+	name1 := randomTestName()
+	name2 := randomTestName()
+	tagName := randomTestName()
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_tag" "foo" {
+  name = "%s"
+}
+
+resource "digitalocean_firewall" "foo" {
+  name = "%s"
+  tags = [digitalocean_tag.foo.id]
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "22"
+    source_addresses = ["0.0.0.0/0", "::/0"]
+  }
+
+  outbound_rule {
+    protocol              = "udp"
+    port_range            = "53"
+    destination_addresses = ["0.0.0.0/0", "::/0"]
+  }
+}
+
+resource "digitalocean_firewall" "bar" {
+  name = "%s"
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "443"
+    source_addresses = ["0.0.0.0/0", "::/0"]
+  }
+}
+`, tagName, name1, name2)
+
+	datasourceConfig := fmt.Sprintf(`
+data "digitalocean_firewalls" "by_name" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+}
+
+data "digitalocean_firewalls" "by_tag" {
+  filter {
+    key    = "tags"
+    values = ["%s"]
+  }
+}
+`, name1, tagName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.by_name", "firewalls.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.by_name", "firewalls.0.name", name1),
+					resource.TestCheckResourceAttrPair("data.digitalocean_firewalls.by_name", "firewalls.0.id", "digitalocean_firewall.foo", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.by_name", "firewalls.0.inbound_rule.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.by_name", "firewalls.0.inbound_rule.0.port_range", "22"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.by_name", "firewalls.0.outbound_rule.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.by_name", "firewalls.0.outbound_rule.0.protocol", "udp"),
+					resource.TestCheckResourceAttr("data.digitalocean_firewalls.by_tag", "firewalls.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_firewalls.by_tag", "firewalls.0.id", "digitalocean_firewall.foo", "id"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
@@ -111,7 +111,7 @@ func takeSnapshotOfDroplet(rInt, sInt int, droplet *godo.Droplet) error {
 	if err != nil {
 		return err
 	}
-	waitForAction(client, action)
+	waitForAction(context.Background(), testAccProvider.Meta(), action)
 	return nil
 }
 
@@ -3,14 +3,41 @@ package digitalocean
 import (
 	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceDigitalOceanImages() *schema.Resource {
 	dataListConfig := &datalist.ResourceConfig{
 		RecordSchema:        imageSchema(),
 		ResultAttributeName: "images",
-		FlattenRecord:       flattenDigitalOceanImage,
-		GetRecords:          getDigitalOceanImages,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "only return the images available in this region",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"disk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "only return the images that can be used with a disk of this size in GB",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "only return the images created after this RFC 3339 date and time",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "only return the images created before this RFC 3339 date and time",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanImage,
+		GetRecords:    getDigitalOceanImages,
 	}
 
 	return datalist.NewResource(dataListConfig)
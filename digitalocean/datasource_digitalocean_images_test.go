@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -35,6 +36,75 @@ data "digitalocean_images" "ubuntu" {
 	})
 }
 
+func TestAccDataSourceDigitalOceanImages_Latest(t *testing.T) {
+	config := `
+data "digitalocean_images" "latest" {
+  region        = "nyc3"
+  disk_size     = 25
+  created_after = "2020-01-01T00:00:00Z"
+
+  filter {
+    key    = "distribution"
+    values = ["Ubuntu"]
+  }
+
+  sort {
+    key       = "created"
+    direction = "desc"
+  }
+}
+`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_images.latest", "images.0.id"),
+					resource.TestCheckResourceAttr("data.digitalocean_images.latest", "images.0.distribution", "Ubuntu"),
+					resource.TestCheckTypeSetElemAttr("data.digitalocean_images.latest", "images.0.regions.*", "nyc3"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterDigitalOceanImages(t *testing.T) {
+	images := []interface{}{
+		godo.Image{ID: 1, Regions: []string{"nyc3"}, MinDiskSize: 20, Created: "2021-01-01T00:00:00Z"},
+		godo.Image{ID: 2, Regions: []string{"nyc3", "sfo3"}, MinDiskSize: 50, Created: "2021-06-01T00:00:00Z"},
+		godo.Image{ID: 3, Regions: []string{"sfo3"}, MinDiskSize: 20, Created: "2021-09-01T00:00:00Z"},
+	}
+
+	cases := []struct {
+		extra    map[string]interface{}
+		expected []int
+	}{
+		{extra: map[string]interface{}{}, expected: []int{1, 2, 3}},
+		{extra: map[string]interface{}{"region": "nyc3"}, expected: []int{1, 2}},
+		{extra: map[string]interface{}{"disk_size": 25}, expected: []int{1, 3}},
+		{extra: map[string]interface{}{"created_after": "2021-01-01T00:00:00Z"}, expected: []int{2, 3}},
+		{extra: map[string]interface{}{"created_before": "2021-09-01T00:00:00Z"}, expected: []int{1, 2}},
+		{extra: map[string]interface{}{"region": "sfo3", "disk_size": 25, "created_after": "2021-01-01T00:00:00Z"}, expected: []int{3}},
+	}
+
+	for _, c := range cases {
+		filtered, err := filterDigitalOceanImages(images, c.extra)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		var ids []int
+		for _, image := range filtered {
+			ids = append(ids, image.(godo.Image).ID)
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(c.expected) {
+			t.Errorf("expected images %v for %v, got %v", c.expected, c.extra, ids)
+		}
+	}
+}
+
 func testAccDataSourceDigitalOceanImages_VerifyImageData(is *terraform.InstanceState) error {
 	ns, ok := is.Attributes["images.#"]
 	if !ok {
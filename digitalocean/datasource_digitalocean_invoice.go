@@ -0,0 +1,141 @@
+package digitalocean
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDigitalOceanInvoice() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanInvoiceRead,
+		Schema: map[string]*schema.Schema{
+			"invoice_uuid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"invoice_uuid", "period"},
+				Description:  "UUID of the invoice",
+			},
+			"period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}$`), "must be a billing period in the YYYY-MM format"),
+				ExactlyOneOf: []string{"invoice_uuid", "period"},
+				Description:  "billing period of the invoice, e.g. 2021-06",
+			},
+			// computed attributes
+			"amount": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "total amount of the invoice in US dollars",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the date and time when the invoice was last updated",
+			},
+			"csv_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API URL of the invoice in CSV format",
+			},
+			"pdf_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API URL of the invoice in PDF format",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "line items of the invoice",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"amount": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanInvoiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	invoice, err := findInvoice(client, d.Get("invoice_uuid").(string), d.Get("period").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items, err := listInvoiceItems(client, invoice.InvoiceUUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(invoice.InvoiceUUID)
+	d.Set("invoice_uuid", invoice.InvoiceUUID)
+	d.Set("period", invoice.InvoicePeriod)
+	d.Set("amount", invoice.Amount)
+	d.Set("updated_at", invoice.UpdatedAt.UTC().Format(time.RFC3339))
+	d.Set("csv_url", invoiceDownloadURL(client, invoice.InvoiceUUID, "csv"))
+	d.Set("pdf_url", invoiceDownloadURL(client, invoice.InvoiceUUID, "pdf"))
+
+	if err := d.Set("items", flattenInvoiceItems(items)); err != nil {
+		return diag.Errorf("Error setting `items`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package digitalocean
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestInvoiceDownloadURL(t *testing.T) {
+	client := godo.NewClient(nil)
+
+	expected := "https://api.digitalocean.com/v2/customers/my/invoices/22737513-0ea7-4206-8ceb-98a575af7681/csv"
+	if actual := invoiceDownloadURL(client, "22737513-0ea7-4206-8ceb-98a575af7681", "csv"); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+
+	// The API endpoint may be configured without a trailing slash.
+	client.BaseURL, _ = url.Parse("https://api.example.com")
+	expected = "https://api.example.com/v2/customers/my/invoices/22737513-0ea7-4206-8ceb-98a575af7681/pdf"
+	if actual := invoiceDownloadURL(client, "22737513-0ea7-4206-8ceb-98a575af7681", "pdf"); actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestAccDataSourceDigitalOceanInvoice_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanInvoiceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_invoice.foobar", "invoice_uuid",
+						"data.digitalocean_billing_history.invoices", "billing_history.0.invoice_uuid"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.foobar", "period"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.foobar", "amount"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.foobar", "csv_url"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.foobar", "pdf_url"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_invoice.by_period", "invoice_uuid",
+						"data.digitalocean_invoice.foobar", "invoice_uuid"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanInvoiceConfig_basic = `
+data "digitalocean_billing_history" "invoices" {
+  filter {
+    key    = "type"
+    values = ["Invoice"]
+  }
+
+  sort {
+    key       = "date"
+    direction = "desc"
+  }
+}
+
+data "digitalocean_invoice" "foobar" {
+  invoice_uuid = data.digitalocean_billing_history.invoices.billing_history[0].invoice_uuid
+}
+
+data "digitalocean_invoice" "by_period" {
+  period = data.digitalocean_invoice.foobar.period
+}`
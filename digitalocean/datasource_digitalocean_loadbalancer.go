@@ -234,12 +234,19 @@ func dataSourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resou
 		opts.Page = page + 1
 	}
 
-	loadbalancer, err := findLoadBalancerByName(lbList, name)
+	foundLoadbalancer, err := findLoadBalancerByName(lbList, name)
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// Retrieve the load balancer individually to include the settings godo
+	// does not model.
+	loadbalancer, _, err := getLoadBalancer(context.Background(), client, foundLoadbalancer.ID)
+	if err != nil {
+		return diag.Errorf("Error retrieving load balancer: %s", err)
+	}
+
 	d.SetId(loadbalancer.ID)
 	d.Set("name", loadbalancer.Name)
 	d.Set("urn", loadbalancer.URN())
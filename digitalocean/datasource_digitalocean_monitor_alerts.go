@@ -0,0 +1,17 @@
+package digitalocean
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDigitalOceanMonitorAlerts() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        monitorAlertSchema(),
+		ResultAttributeName: "alerts",
+		GetRecords:          getDigitalOceanMonitorAlerts,
+		FlattenRecord:       flattenDigitalOceanMonitorAlert,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
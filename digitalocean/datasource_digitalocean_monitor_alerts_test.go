@@ -0,0 +1,77 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanMonitorAlerts_Basic(t *testing.T) {
+	tagName := randomTestName()
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_tag" "foo" {
+  name = "%s"
+}
+
+resource "digitalocean_monitor_alert" "cpu" {
+  alerts {
+    email = ["benny@digitalocean.com"]
+  }
+  window      = "5m"
+  type        = "v1/insights/droplet/cpu"
+  compare     = "GreaterThan"
+  value       = 95
+  tags        = [digitalocean_tag.foo.name]
+  description = "Alert about CPU usage"
+}
+
+resource "digitalocean_monitor_alert" "memory" {
+  alerts {
+    email = ["benny@digitalocean.com"]
+  }
+  window      = "5m"
+  type        = "v1/insights/droplet/memory_utilization_percent"
+  compare     = "GreaterThan"
+  value       = 90
+  tags        = [digitalocean_tag.foo.name]
+  description = "Alert about memory usage"
+}
+`, tagName)
+
+	datasourceConfig := fmt.Sprintf(`
+data "digitalocean_monitor_alerts" "result" {
+  filter {
+    key    = "tags"
+    values = ["%s"]
+  }
+  sort {
+    key       = "description"
+    direction = "asc"
+  }
+}
+`, tagName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_monitor_alerts.result", "alerts.#", "2"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_monitor_alerts.result", "alerts.0.uuid", "digitalocean_monitor_alert.cpu", "uuid"),
+					resource.TestCheckResourceAttr("data.digitalocean_monitor_alerts.result", "alerts.0.type", "v1/insights/droplet/cpu"),
+					resource.TestCheckResourceAttr("data.digitalocean_monitor_alerts.result", "alerts.0.value", "95"),
+					resource.TestCheckResourceAttr("data.digitalocean_monitor_alerts.result", "alerts.0.alerts.0.email.0", "benny@digitalocean.com"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_monitor_alerts.result", "alerts.1.uuid", "digitalocean_monitor_alert.memory", "uuid"),
+					resource.TestCheckResourceAttr("data.digitalocean_monitor_alerts.result", "alerts.1.tags.#", "1"),
+				),
+			},
+		},
+	})
+}
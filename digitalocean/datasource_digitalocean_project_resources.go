@@ -0,0 +1,26 @@
+package digitalocean
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDigitalOceanProjectResources() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        projectResourceSchema(),
+		ResultAttributeName: "resources",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				Description:  "the ID of the project, the default project if not set",
+			},
+		},
+		FlattenRecord: flattenDigitalOceanProjectResource,
+		GetRecords:    getDigitalOceanProjectResources,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,86 @@
+package digitalocean
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestParseURN(t *testing.T) {
+	cases := []struct {
+		urn          string
+		expectedType string
+		expectedID   string
+		expectError  bool
+	}{
+		{urn: "do:droplet:4126873", expectedType: "droplet", expectedID: "4126873"},
+		{urn: "do:space:my-website-assets", expectedType: "space", expectedID: "my-website-assets"},
+		{urn: "do:domain:example.com", expectedType: "domain", expectedID: "example.com"},
+		{urn: "do:kubernetes:bd5f5959-5e1e-4205-a714-a914373942af", expectedType: "kubernetes", expectedID: "bd5f5959-5e1e-4205-a714-a914373942af"},
+		{urn: "do:droplet", expectError: true},
+		{urn: "do::4126873", expectError: true},
+		{urn: "aws:droplet:4126873", expectError: true},
+		{urn: "", expectError: true},
+	}
+
+	for _, c := range cases {
+		resourceType, resourceID, err := parseURN(c.urn)
+		if c.expectError {
+			if err == nil {
+				t.Errorf("expected an error parsing %q", c.urn)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", c.urn, err)
+			continue
+		}
+
+		if resourceType != c.expectedType || resourceID != c.expectedID {
+			t.Errorf("expected %q to be parsed into %s and %s, got %s and %s", c.urn, c.expectedType, c.expectedID, resourceType, resourceID)
+		}
+	}
+}
+
+func TestAccDataSourceDigitalOceanProjectResources_Basic(t *testing.T) {
+	projectName := randomName("tf-acc-project-", 6)
+	dropletName := randomTestName()
+
+	resourcesConfig := fixtureCreateWithDropletResource(dropletName, projectName)
+	datasourceConfig := `
+data "digitalocean_project_resources" "foobar" {
+  project_id = digitalocean_project.myproj.id
+
+  filter {
+    key    = "type"
+    values = ["droplet"]
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_project_resources.foobar", "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_project_resources.foobar", "resources.0.urn",
+						"digitalocean_droplet.foobar", "urn"),
+					resource.TestCheckResourceAttr("data.digitalocean_project_resources.foobar", "resources.0.type", "droplet"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_project_resources.foobar", "resources.0.resource_id",
+						"digitalocean_droplet.foobar", "id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_project_resources.foobar", "resources.0.assigned_at"),
+				),
+			},
+		},
+	})
+}
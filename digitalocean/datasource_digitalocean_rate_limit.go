@@ -0,0 +1,53 @@
+package digitalocean
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDigitalOceanRateLimit() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanRateLimitRead,
+		Schema: map[string]*schema.Schema{
+			"limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests that can be made per hour.",
+			},
+			"remaining": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests remaining in the current rate limit window.",
+			},
+			"reset": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the current rate limit window resets.",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanRateLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	// The rate limit is reported in the headers of every response. Retrieving
+	// the account is one of the cheapest requests, it counts against the limit
+	// like any other one.
+	_, resp, err := client.Account.Get(context.Background())
+	if err != nil {
+		return diag.Errorf("Error retrieving rate limit: %s", err)
+	}
+
+	reset := resp.Rate.Reset.UTC().Format(time.RFC3339)
+
+	d.SetId(reset)
+	d.Set("limit", resp.Rate.Limit)
+	d.Set("remaining", resp.Rate.Remaining)
+	d.Set("reset", reset)
+
+	return nil
+}
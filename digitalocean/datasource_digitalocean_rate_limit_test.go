@@ -0,0 +1,31 @@
+package digitalocean
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanRateLimit_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanRateLimitConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_rate_limit.foobar", "limit"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_rate_limit.foobar", "remaining"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_rate_limit.foobar", "reset"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanRateLimitConfig_basic = `
+data "digitalocean_rate_limit" "foobar" {
+}`
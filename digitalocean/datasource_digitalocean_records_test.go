@@ -68,3 +68,73 @@ data "digitalocean_records" "result" {
 		},
 	})
 }
+
+func TestAccDataSourceDigitalOceanRecords_FilterByNameRegex(t *testing.T) {
+	name1 := fmt.Sprintf("foobar-test-terraform-%s.com", acctest.RandString(10))
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_domain" "foo" {
+  name = "%s"
+}
+
+resource "digitalocean_record" "www" {
+  name = "www"
+  domain = digitalocean_domain.foo.name
+  type = "A"
+  value = "192.168.1.1"
+}
+
+resource "digitalocean_record" "www2" {
+  name = "www2"
+  domain = digitalocean_domain.foo.name
+  type = "A"
+  value = "192.168.1.2"
+}
+
+resource "digitalocean_record" "api" {
+  name = "api"
+  domain = digitalocean_domain.foo.name
+  type = "A"
+  value = "192.168.1.3"
+}
+`, name1)
+
+	datasourceConfig := fmt.Sprintf(`
+data "digitalocean_records" "result" {
+  domain = "%s"
+  filter {
+    key = "name"
+    values = ["^www"]
+    match_by = "re"
+  }
+  filter {
+    key = "type"
+    values = ["A"]
+  }
+  sort {
+    key = "value"
+    direction = "desc"
+  }
+}
+`, name1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_records.result", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_records.result", "records.0.name", "www2"),
+					resource.TestCheckResourceAttr("data.digitalocean_records.result", "records.0.value", "192.168.1.2"),
+					resource.TestCheckResourceAttr("data.digitalocean_records.result", "records.1.name", "www"),
+					resource.TestCheckResourceAttr("data.digitalocean_records.result", "records.1.value", "192.168.1.1"),
+				),
+			},
+		},
+	})
+}
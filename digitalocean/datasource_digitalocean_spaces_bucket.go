@@ -18,8 +18,8 @@ func dataSourceDigitalOceanSpacesBucket() *schema.Resource {
 		f.Computed = true
 	}
 
-	recordSchema["region"].Required = true
-	recordSchema["region"].Computed = false
+	recordSchema["region"].Optional = true
+	recordSchema["region"].Computed = true
 	recordSchema["region"].ValidateFunc = validation.StringInSlice(SpacesRegions, true)
 	recordSchema["name"].Required = true
 	recordSchema["name"].Computed = false
@@ -31,7 +31,10 @@ func dataSourceDigitalOceanSpacesBucket() *schema.Resource {
 }
 
 func dataSourceDigitalOceanSpacesBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	region := d.Get("region").(string)
+	region, err := meta.(*CombinedConfig).spacesRegion(d.Get("region").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	name := d.Get("name").(string)
 
 	client, err := meta.(*CombinedConfig).spacesClient(region)
@@ -28,7 +28,8 @@ func dataSourceDigitalOceanSpacesBucketObject() *schema.Resource {
 			},
 			"region": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(SpacesRegions, true),
 			},
 
@@ -104,7 +105,12 @@ func dataSourceDigitalOceanSpacesBucketObject() *schema.Resource {
 }
 
 func dataSourceDigitalOceanSpacesBucketObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	region := d.Get("region").(string)
+	region, err := meta.(*CombinedConfig).spacesRegion(d.Get("region").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("region", region)
+
 	client, err := meta.(*CombinedConfig).spacesClient(region)
 	if err != nil {
 		return diag.FromErr(err)
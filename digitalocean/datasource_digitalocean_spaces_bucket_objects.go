@@ -25,7 +25,8 @@ func dataSourceDigitalOceanSpacesBucketObjects() *schema.Resource {
 			},
 			"region": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(SpacesRegions, true),
 			},
 
@@ -69,7 +70,12 @@ func dataSourceDigitalOceanSpacesBucketObjects() *schema.Resource {
 }
 
 func dataSourceDigitalOceanSpacesBucketObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	region := d.Get("region").(string)
+	region, err := meta.(*CombinedConfig).spacesRegion(d.Get("region").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("region", region)
+
 	client, err := meta.(*CombinedConfig).spacesClient(region)
 	if err != nil {
 		return diag.FromErr(err)
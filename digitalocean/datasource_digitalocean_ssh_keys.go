@@ -3,14 +3,42 @@ package digitalocean
 import (
 	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceDigitalOceanSSHKeys() *schema.Resource {
 	dataListConfig := &datalist.ResourceConfig{
 		RecordSchema:        sshKeySchema(),
 		ResultAttributeName: "ssh_keys",
-		GetRecords:          getDigitalOceanSshKeys,
-		FlattenRecord:       flattenDigitalOceanSshKey,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "only return the ssh keys whose name matches this regular expression",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"fingerprints": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "only return the ssh keys with one of these fingerprints",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "ids of the ssh keys found",
+			},
+			"matching_fingerprints": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "fingerprints of the ssh keys found",
+			},
+		},
+		GetRecords:       getDigitalOceanSshKeys,
+		FlattenRecord:    flattenDigitalOceanSshKey,
+		SummarizeRecords: summarizeDigitalOceanSshKeys,
 	}
 
 	return datalist.NewResource(dataListConfig)
@@ -2,10 +2,13 @@ package digitalocean
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"reflect"
 	"testing"
 
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccDataSourceDigitalOceanSSHKeys_Basic(t *testing.T) {
@@ -63,3 +66,94 @@ data "digitalocean_ssh_keys" "result" {
 		},
 	})
 }
+
+func TestAccDataSourceDigitalOceanSSHKeys_NameRegex(t *testing.T) {
+	prefix := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	pubKey1, err := testAccGenerateDataSourceDigitalOceanSSHKeyPublic()
+	if err != nil {
+		t.Fatalf("Unable to generate public key: %v", err)
+	}
+	pubKey2, err := testAccGenerateDataSourceDigitalOceanSSHKeyPublic()
+	if err != nil {
+		t.Fatalf("Unable to generate public key: %v", err)
+	}
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_ssh_key" "foo" {
+  name       = "%s-team-foo"
+  public_key = "%s"
+}
+
+resource "digitalocean_ssh_key" "bar" {
+  name       = "%s-bar"
+  public_key = "%s"
+}
+`, prefix, pubKey1, prefix, pubKey2)
+
+	datasourceConfig := fmt.Sprintf(`
+data "digitalocean_ssh_keys" "team" {
+  name_regex = "^%s-team-"
+}
+
+data "digitalocean_ssh_keys" "bar" {
+  fingerprints = [digitalocean_ssh_key.bar.fingerprint]
+}
+`, prefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_ssh_keys.team", "ssh_keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_ssh_keys.team", "ids.0", "digitalocean_ssh_key.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_ssh_keys.team", "matching_fingerprints.0", "digitalocean_ssh_key.foo", "fingerprint"),
+					resource.TestCheckResourceAttr("data.digitalocean_ssh_keys.bar", "ssh_keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_ssh_keys.bar", "ssh_keys.0.name", "digitalocean_ssh_key.bar", "name"),
+				),
+			},
+			{
+				Config: resourcesConfig,
+			},
+		},
+	})
+}
+
+func TestFilterDigitalOceanSshKeys(t *testing.T) {
+	keys := []interface{}{
+		godo.Key{ID: 1, Name: "team-alice", Fingerprint: "aa:bb"},
+		godo.Key{ID: 2, Name: "team-bob", Fingerprint: "cc:dd"},
+		godo.Key{ID: 3, Name: "ci", Fingerprint: "ee:ff"},
+	}
+
+	cases := []struct {
+		extra    map[string]interface{}
+		expected []int
+	}{
+		{extra: nil, expected: []int{1, 2, 3}},
+		{extra: map[string]interface{}{"name_regex": "^team-"}, expected: []int{1, 2}},
+		{extra: map[string]interface{}{"fingerprints": schema.NewSet(schema.HashString, []interface{}{"EE:FF", "aa:bb"})}, expected: []int{1, 3}},
+		{extra: map[string]interface{}{"name_regex": "^team-", "fingerprints": schema.NewSet(schema.HashString, []interface{}{"ee:ff", "aa:bb"})}, expected: []int{1}},
+		{extra: map[string]interface{}{"name_regex": "nobody"}, expected: nil},
+	}
+
+	for _, c := range cases {
+		filtered, err := filterDigitalOceanSshKeys(keys, c.extra)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		var actual []int
+		for _, key := range filtered {
+			actual = append(actual, key.(godo.Key).ID)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("expected keys %v for %v, got %v", c.expected, c.extra, actual)
+		}
+	}
+}
@@ -61,12 +61,9 @@ func dataSourceDigitalOceanTagRead(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(tag.Name)
 	d.Set("name", tag.Name)
-	d.Set("total_resource_count", tag.Resources.Count)
-	d.Set("droplets_count", tag.Resources.Droplets.Count)
-	d.Set("images_count", tag.Resources.Images.Count)
-	d.Set("volumes_count", tag.Resources.Volumes.Count)
-	d.Set("volume_snapshots_count", tag.Resources.VolumeSnapshots.Count)
-	d.Set("databases_count", tag.Resources.Databases.Count)
+	for key, count := range flattenTagResourceCounts(tag.Resources) {
+		d.Set(key, count)
+	}
 
 	return nil
 }
@@ -3,10 +3,13 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceDigitalOceanTags() *schema.Resource {
@@ -35,8 +38,34 @@ func dataSourceDigitalOceanTags() *schema.Resource {
 			},
 		},
 		ResultAttributeName: "tags",
-		FlattenRecord:       flattenDigitalOceanTag,
-		GetRecords:          getDigitalOceanTags,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "only return the tags whose name starts with this prefix",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "only return the tags whose name matches this regular expression",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "names of the tags found",
+			},
+			"total_resource_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "total number of resources the tags found are applied to",
+			},
+		},
+		FlattenRecord:    flattenDigitalOceanTag,
+		GetRecords:       getDigitalOceanTags,
+		SummarizeRecords: summarizeDigitalOceanTags,
 	}
 
 	return datalist.NewResource(dataListConfig)
@@ -45,47 +74,76 @@ func dataSourceDigitalOceanTags() *schema.Resource {
 func getDigitalOceanTags(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	client := meta.(*CombinedConfig).godoClient()
 
-	tagsList := []interface{}{}
+	tags, err := listAllPages(context.Background(), func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		tags, resp, err := client.Tags.List(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
 
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
+		items := make([]interface{}, len(tags))
+		for i, tag := range tags {
+			items[i] = tag
+		}
+		return items, resp, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving tags: %s", err)
 	}
 
-	for {
-		tags, resp, err := client.Tags.List(context.Background(), opts)
-		if err != nil {
-			return nil, fmt.Errorf("Error retrieving tags: %s", err)
-		}
+	return filterDigitalOceanTags(tags, extra)
+}
 
-		tagsList = append(tagsList, tags)
+// filterDigitalOceanTags returns the tags matching the name_prefix and
+// name_regex arguments of the digitalocean_tags data source.
+func filterDigitalOceanTags(tags []interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	prefix, _ := extra["name_prefix"].(string)
 
-		if resp.Links == nil || resp.Links.IsLastPage() {
-			break
+	var nameRegex *regexp.Regexp
+	if v, _ := extra["name_regex"].(string); v != "" {
+		var err error
+		if nameRegex, err = regexp.Compile(v); err != nil {
+			return nil, fmt.Errorf("Error parsing name_regex: %s", err)
 		}
+	}
 
-		page, err := resp.Links.CurrentPage()
-		if err != nil {
-			return nil, fmt.Errorf("Error retrieving tags: %s", err)
-		}
+	if prefix == "" && nameRegex == nil {
+		return tags, nil
+	}
 
-		opts.Page = page + 1
+	var matching []interface{}
+	for _, item := range tags {
+		name := item.(godo.Tag).Name
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		matching = append(matching, item)
 	}
 
-	return tagsList, nil
+	return matching, nil
 }
 
 func flattenDigitalOceanTag(tag, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
 	t := tag.(godo.Tag)
 
-	flattenedTag := map[string]interface{}{}
+	flattenedTag := flattenTagResourceCounts(t.Resources)
 	flattenedTag["name"] = t.Name
-	flattenedTag["total_resource_count"] = t.Resources.Count
-	flattenedTag["droplets_count"] = t.Resources.Droplets.Count
-	flattenedTag["images_count"] = t.Resources.Images.Count
-	flattenedTag["volumes_count"] = t.Resources.Volumes.Count
-	flattenedTag["volume_snapshots_count"] = t.Resources.VolumeSnapshots.Count
-	flattenedTag["databases_count"] = t.Resources.Databases.Count
 
 	return flattenedTag, nil
 }
+
+func summarizeDigitalOceanTags(records []map[string]interface{}) (map[string]interface{}, error) {
+	names := make([]interface{}, len(records))
+	total := 0
+	for i, record := range records {
+		names[i] = record["name"]
+		total += record["total_resource_count"].(int)
+	}
+
+	return map[string]interface{}{
+		"names":                names,
+		"total_resource_count": total,
+	}, nil
+}
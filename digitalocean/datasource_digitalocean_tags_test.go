@@ -2,6 +2,7 @@ package digitalocean
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -53,3 +54,92 @@ data "digitalocean_tags" "foobar" {
 		},
 	})
 }
+
+func TestAccDataSourceDigitalOceanTags_NamePrefix(t *testing.T) {
+	prefix := randomTestName()
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_tag" "foo" {
+  name = "%s-foo"
+}
+
+resource "digitalocean_tag" "bar" {
+  name = "%s-bar"
+}
+
+resource "digitalocean_tag" "other" {
+  name = "other-%s"
+}`, prefix, prefix, prefix)
+	dataSourceConfig := fmt.Sprintf(`
+data "digitalocean_tags" "prefixed" {
+  name_prefix = "%s-"
+
+  sort {
+    key       = "name"
+    direction = "asc"
+  }
+
+  depends_on = [digitalocean_tag.foo, digitalocean_tag.bar, digitalocean_tag.other]
+}
+
+data "digitalocean_tags" "regex" {
+  name_regex = "^%s-f"
+
+  depends_on = [digitalocean_tag.foo, digitalocean_tag.bar, digitalocean_tag.other]
+}`, prefix, prefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_tags.prefixed", "tags.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_tags.prefixed", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_tags.prefixed", "names.0", prefix+"-bar"),
+					resource.TestCheckResourceAttr("data.digitalocean_tags.prefixed", "names.1", prefix+"-foo"),
+					resource.TestCheckResourceAttr("data.digitalocean_tags.prefixed", "total_resource_count", "0"),
+					resource.TestCheckResourceAttr("data.digitalocean_tags.regex", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_tags.regex", "names.0", prefix+"-foo"),
+				),
+			},
+		},
+	})
+}
+
+func TestFilterDigitalOceanTags(t *testing.T) {
+	tags := []interface{}{
+		godo.Tag{Name: "env:prod"},
+		godo.Tag{Name: "env:staging"},
+		godo.Tag{Name: "team:web"},
+	}
+
+	cases := []struct {
+		extra    map[string]interface{}
+		expected []string
+	}{
+		{extra: map[string]interface{}{}, expected: []string{"env:prod", "env:staging", "team:web"}},
+		{extra: map[string]interface{}{"name_prefix": "env:"}, expected: []string{"env:prod", "env:staging"}},
+		{extra: map[string]interface{}{"name_regex": "prod$|web$"}, expected: []string{"env:prod", "team:web"}},
+		{extra: map[string]interface{}{"name_prefix": "env:", "name_regex": "prod$|web$"}, expected: []string{"env:prod"}},
+		{extra: map[string]interface{}{"name_prefix": "owner:"}, expected: nil},
+	}
+
+	for _, c := range cases {
+		filtered, err := filterDigitalOceanTags(tags, c.extra)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		var actual []string
+		for _, tag := range filtered {
+			actual = append(actual, tag.(godo.Tag).Name)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("expected tags %v for %v, got %v", c.expected, c.extra, actual)
+		}
+	}
+}
@@ -82,32 +82,9 @@ func dataSourceDigitalOceanVolumeSnapshotRead(ctx context.Context, d *schema.Res
 		return diag.Errorf("One of `name` or `name_regex` must be assigned")
 	}
 
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
-	}
-
-	var snapshotList []godo.Snapshot
-
-	for {
-		snapshots, resp, err := client.Snapshots.ListVolume(context.Background(), opts)
-
-		if err != nil {
-			return diag.Errorf("Error retrieving volume snapshots: %s", err)
-		}
-
-		snapshotList = append(snapshotList, snapshots...)
-
-		if resp.Links == nil || resp.Links.IsLastPage() {
-			break
-		}
-
-		page, err := resp.Links.CurrentPage()
-		if err != nil {
-			return diag.Errorf("Error retrieving volume snapshots: %s", err)
-		}
-
-		opts.Page = page + 1
+	snapshotList, err := listDigitalOceanSnapshots(client.Snapshots.ListVolume)
+	if err != nil {
+		return diag.Errorf("Error retrieving volume snapshots: %s", err)
 	}
 
 	// Go through all the possible filters
@@ -150,6 +127,31 @@ func dataSourceDigitalOceanVolumeSnapshotRead(ctx context.Context, d *schema.Res
 	return nil
 }
 
+// listDigitalOceanSnapshots returns all of the snapshots listed by list.
+func listDigitalOceanSnapshots(list func(context.Context, *godo.ListOptions) ([]godo.Snapshot, *godo.Response, error)) ([]godo.Snapshot, error) {
+	items, err := listAllPages(context.Background(), func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		snapshots, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		items := make([]interface{}, len(snapshots))
+		for i, snapshot := range snapshots {
+			items[i] = snapshot
+		}
+		return items, resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]godo.Snapshot, len(items))
+	for i, item := range items {
+		snapshots[i] = item.(godo.Snapshot)
+	}
+	return snapshots, nil
+}
+
 func filterSnapshotsByName(snapshots []godo.Snapshot, name string) []godo.Snapshot {
 	result := make([]godo.Snapshot, 0)
 	for _, s := range snapshots {
@@ -3,6 +3,7 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,21 +20,27 @@ func dataSourceDigitalOceanVPC() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
-				ExactlyOneOf: []string{"id", "name", "region"},
+				ExactlyOneOf: []string{"id", "name", "region", "ip_address"},
 			},
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
-				ExactlyOneOf: []string{"id", "name", "region"},
+				ExactlyOneOf: []string{"id", "name", "region", "ip_address"},
 			},
 			"region": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
-				ExactlyOneOf: []string{"id", "name", "region"},
+				ExactlyOneOf: []string{"id", "name", "region", "ip_address"},
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				ExactlyOneOf: []string{"id", "name", "region", "ip_address"},
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -93,6 +100,18 @@ func dataSourceDigitalOceanVPCRead(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("Error retrieving VPC: %s", err)
 		}
 
+		foundVPC = vpc
+	} else if ip, ok := d.GetOk("ip_address"); ok {
+		vpcs, err := listVPCs(client)
+		if err != nil {
+			return diag.Errorf("Error retrieving VPC: %s", err)
+		}
+
+		vpc, err := findVPCContainingIP(vpcs, ip.(string))
+		if err != nil {
+			return diag.Errorf("Error retrieving VPC: %s", err)
+		}
+
 		foundVPC = vpc
 	}
 
@@ -168,3 +187,29 @@ func findVPCByName(vpcs []*godo.VPC, name string) (*godo.VPC, error) {
 
 	return nil, fmt.Errorf("too many VPCs found with name %s (found %d, expected 1)", name, len(results))
 }
+
+func findVPCContainingIP(vpcs []*godo.VPC, ip string) (*godo.VPC, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return nil, fmt.Errorf("invalid IP address %s", ip)
+	}
+
+	results := make([]*godo.VPC, 0)
+	for _, v := range vpcs {
+		_, ipRange, err := net.ParseCIDR(v.IPRange)
+		if err != nil {
+			continue
+		}
+
+		if ipRange.Contains(addr) {
+			results = append(results, v)
+		}
+	}
+	if len(results) == 1 {
+		return results[0], nil
+	} else if len(results) == 0 {
+		return nil, fmt.Errorf("no VPCs found containing IP address %s", ip)
+	}
+
+	return nil, fmt.Errorf("too many VPCs found containing IP address %s (found %d, expected 1)", ip, len(results))
+}
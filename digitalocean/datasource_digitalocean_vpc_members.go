@@ -0,0 +1,25 @@
+package digitalocean
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDigitalOceanVPCMembers() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        vpcMembersSchema(),
+		ResultAttributeName: "members",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		FlattenRecord: flattenDigitalOceanVPCMember,
+		GetRecords:    getDigitalOceanVPCMembers,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
@@ -0,0 +1,59 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanVPCMembers_Basic(t *testing.T) {
+	vpcName := randomTestName()
+	dropletName := randomTestName()
+
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_vpc" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name     = "%s"
+  size     = "s-1vcpu-1gb"
+  image    = "ubuntu-20-04-x64"
+  region   = "nyc3"
+  vpc_uuid = digitalocean_vpc.foobar.id
+}
+`, vpcName, dropletName)
+
+	datasourceConfig := `
+data "digitalocean_vpc_members" "result" {
+  vpc_id = digitalocean_vpc.foobar.id
+  filter {
+    key    = "resource_type"
+    values = ["droplet"]
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + datasourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_vpc_members.result", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_vpc_members.result", "members.0.name", dropletName),
+					resource.TestCheckResourceAttr("data.digitalocean_vpc_members.result", "members.0.resource_type", "droplet"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_vpc_members.result", "members.0.resource_id", "digitalocean_droplet.foobar", "id"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_vpc_members.result", "members.0.urn", "digitalocean_droplet.foobar", "urn"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_vpc_members.result", "members.0.created_at"),
+				),
+			},
+		},
+	})
+}
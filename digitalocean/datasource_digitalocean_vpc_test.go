@@ -46,6 +46,39 @@ data "digitalocean_vpc" "foobar" {
 	})
 }
 
+func TestAccDataSourceDigitalOceanVPC_ByIPAddress(t *testing.T) {
+	vpcName := randomTestName()
+	resourceConfig := fmt.Sprintf(testAccCheckDataSourceDigitalOceanVPCConfig_IPRange, vpcName)
+	dataSourceConfig := `
+data "digitalocean_vpc" "foobar" {
+  ip_address = "10.10.10.5"
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanVPCExists("data.digitalocean_vpc.foobar"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_vpc.foobar", "id", "digitalocean_vpc.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_vpc.foobar", "name", vpcName),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_vpc.foobar", "region", "nyc3"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_vpc.foobar", "ip_range", "10.10.10.0/24"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSourceDigitalOceanVPC_RegionDefault(t *testing.T) {
 	vpcDropletName := randomTestName()
 	vpcConfigRegionDefault := fmt.Sprintf(testAccCheckDataSourceDigitalOceanVPCConfig_RegionDefault, vpcDropletName)
@@ -97,6 +130,13 @@ resource "digitalocean_vpc" "foobar" {
 	region      = "nyc3"
 }`
 
+const testAccCheckDataSourceDigitalOceanVPCConfig_IPRange = `
+resource "digitalocean_vpc" "foobar" {
+	name     = "%s"
+	region   = "nyc3"
+	ip_range = "10.10.10.0/24"
+}`
+
 const testAccCheckDataSourceDigitalOceanVPCConfig_RegionDefault = `
 // Create Droplet to ensure default VPC exists
 resource "digitalocean_droplet" "foo" {
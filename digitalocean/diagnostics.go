@@ -0,0 +1,107 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiErrorPattern matches the errors returned by godo for the error responses
+// of the API, e.g. `POST https://api.digitalocean.com/v2/droplets: 422
+// (request "c2a3...") You specified an invalid size for Droplet creation.`,
+// along with the text the resources prefix them with.
+var apiErrorPattern = regexp.MustCompile(`(?s)^(.*?)\b(GET|HEAD|POST|PUT|PATCH|DELETE) (\S+): (\d{3}) (?:\(request "([^"]*)"\) )?(.*)$`)
+
+// withAPIErrorDiagnostics makes the operations of r report the errors
+// returned by the API with their HTTP status, their request ID and a hint on
+// how to fix them, rather than with the raw error.
+func withAPIErrorDiagnostics(r *schema.Resource) *schema.Resource {
+	r.CreateContext = apiErrorDiagnosticsFunc(r.CreateContext)
+	r.ReadContext = apiErrorDiagnosticsFunc(r.ReadContext)
+	r.UpdateContext = apiErrorDiagnosticsFunc(r.UpdateContext)
+	r.DeleteContext = apiErrorDiagnosticsFunc(r.DeleteContext)
+
+	return r
+}
+
+func apiErrorDiagnosticsFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return apiErrorDiagnostics(f(ctx, d, meta))
+	}
+}
+
+// apiErrorDiagnostics rewrites the diagnostics reporting an API error
+// response. The other diagnostics are left untouched.
+func apiErrorDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	for i, d := range diags {
+		if d.Severity != diag.Error || d.Detail != "" {
+			continue
+		}
+
+		m := apiErrorPattern.FindStringSubmatch(d.Summary)
+		if m == nil {
+			continue
+		}
+		prefix, method, url, requestID, message := m[1], m[2], m[3], m[5], strings.TrimSpace(m[6])
+		status, _ := strconv.Atoi(m[4])
+
+		if message == "" {
+			message = http.StatusText(status)
+		}
+		diags[i].Summary = prefix + message
+
+		var detail strings.Builder
+		fmt.Fprintf(&detail, "HTTP status: %d %s\n", status, http.StatusText(status))
+		fmt.Fprintf(&detail, "Request: %s %s\n", method, url)
+		if requestID != "" {
+			fmt.Fprintf(&detail, "Request ID: %s\n", requestID)
+		}
+		if hint := apiErrorHint(status, message); hint != "" {
+			fmt.Fprintf(&detail, "\n%s", hint)
+		}
+		diags[i].Detail = strings.TrimSuffix(detail.String(), "\n")
+	}
+
+	return diags
+}
+
+// apiErrorHint returns how to fix the cause of an API error response, or an
+// empty string if it is not known.
+func apiErrorHint(status int, message string) string {
+	message = strings.ToLower(message)
+
+	switch {
+	case status == http.StatusUnauthorized:
+		return "Check that the token of the provider is valid and has not expired or been revoked."
+	case status == http.StatusForbidden:
+		return "Check that the token of the provider has write access and that the team can use this product."
+	case status == http.StatusNotFound:
+		return "Check the ID of the resource, it may have been deleted outside of Terraform."
+	case status == http.StatusTooManyRequests:
+		return "The rate limit of the API has been exceeded. Lower requests_per_second or raise http_retry_max in the provider configuration."
+	case status >= http.StatusInternalServerError:
+		return "The API failed to process the request. Try again later, and contact support with the request ID if the error persists."
+	case strings.Contains(message, "limit") || strings.Contains(message, "quota") || strings.Contains(message, "exceed"):
+		return "The account has reached one of its resource limits. Remove the resources not in use, or request a limit increase from the control panel."
+	case strings.Contains(message, "size") && (strings.Contains(message, "region") || strings.Contains(message, "invalid") || strings.Contains(message, "not available")):
+		return "Check that the size is available in the region, the digitalocean_sizes data source lists the sizes available in each region."
+	case strings.Contains(message, "region") && (strings.Contains(message, "invalid") || strings.Contains(message, "not available") || strings.Contains(message, "unavailable")):
+		return "Check that the region supports this product, the digitalocean_regions data source lists the features available in each region."
+	case strings.Contains(message, "image") && (strings.Contains(message, "invalid") || strings.Contains(message, "not available")):
+		return "Check that the image slug or ID exists and is available in the region, the digitalocean_images data source lists them."
+	case strings.Contains(message, "already") && (strings.Contains(message, "taken") || strings.Contains(message, "exists") || strings.Contains(message, "in use")):
+		return "The name must be unique. Choose another name, or import the existing resource with terraform import."
+	}
+
+	return ""
+}
@@ -0,0 +1,104 @@
+package digitalocean
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	diags := apiErrorDiagnostics(diag.Errorf("Error creating droplet: %s", errors.New(`POST https://api.digitalocean.com/v2/droplets: 422 (request "2a3c5e7f") You specified an invalid size for Droplet creation.`)))
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+	}
+	if expected := "Error creating droplet: You specified an invalid size for Droplet creation."; diags[0].Summary != expected {
+		t.Errorf("expected summary %q, got %q", expected, diags[0].Summary)
+	}
+	for _, expected := range []string{
+		"HTTP status: 422 Unprocessable Entity",
+		"Request: POST https://api.digitalocean.com/v2/droplets",
+		"Request ID: 2a3c5e7f",
+		"digitalocean_sizes",
+	} {
+		if !strings.Contains(diags[0].Detail, expected) {
+			t.Errorf("expected the detail to contain %q, got %q", expected, diags[0].Detail)
+		}
+	}
+}
+
+func TestAPIErrorDiagnostics_NoRequestID(t *testing.T) {
+	diags := apiErrorDiagnostics(diag.Errorf("Error retrieving volume: %s", errors.New(`GET https://api.digitalocean.com/v2/volumes/abc: 429 Too many requests`)))
+
+	if expected := "Error retrieving volume: Too many requests"; diags[0].Summary != expected {
+		t.Errorf("expected summary %q, got %q", expected, diags[0].Summary)
+	}
+	if strings.Contains(diags[0].Detail, "Request ID") {
+		t.Errorf("expected no request ID in the detail, got %q", diags[0].Detail)
+	}
+	if !strings.Contains(diags[0].Detail, "requests_per_second") {
+		t.Errorf("expected a rate limit hint in the detail, got %q", diags[0].Detail)
+	}
+}
+
+func TestAPIErrorDiagnostics_Unchanged(t *testing.T) {
+	cases := []diag.Diagnostics{
+		diag.Errorf("Error creating droplet: timeout while waiting for state to become 'active'"),
+		{{Severity: diag.Warning, Summary: "GET https://api.digitalocean.com/v2/account: 404 Not found"}},
+		{{Severity: diag.Error, Summary: "GET https://api.digitalocean.com/v2/account: 404 Not found", Detail: "already detailed"}},
+	}
+
+	for _, c := range cases {
+		summary, detail := c[0].Summary, c[0].Detail
+		diags := apiErrorDiagnostics(c)
+		if diags[0].Summary != summary || diags[0].Detail != detail {
+			t.Errorf("expected %q to be left untouched, got %q (%q)", summary, diags[0].Summary, diags[0].Detail)
+		}
+	}
+}
+
+func TestAPIErrorHint(t *testing.T) {
+	cases := []struct {
+		status   int
+		message  string
+		expected string
+	}{
+		{status: 401, message: "Unable to authenticate you", expected: "token"},
+		{status: 422, message: "creating this/these droplet(s) will exceed your droplet limit", expected: "limit increase"},
+		{status: 422, message: "Region is not available", expected: "digitalocean_regions"},
+		{status: 422, message: "Name is already in use", expected: "terraform import"},
+		{status: 503, message: "Service unavailable", expected: "Try again later"},
+		{status: 422, message: "Something unexpected", expected: ""},
+	}
+
+	for _, c := range cases {
+		hint := apiErrorHint(c.status, c.message)
+		if c.expected == "" && hint != "" {
+			t.Errorf("expected no hint for %d %q, got %q", c.status, c.message, hint)
+		}
+		if !strings.Contains(hint, c.expected) {
+			t.Errorf("expected the hint for %d %q to contain %q, got %q", c.status, c.message, c.expected, hint)
+		}
+	}
+}
+
+func TestWithAPIErrorDiagnostics(t *testing.T) {
+	r := withAPIErrorDiagnostics(&schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.Errorf("Error retrieving tag: %s", errors.New(`GET https://api.digitalocean.com/v2/tags/foo: 403 (request "abc") You are not authorized to perform this operation`))
+		},
+	})
+
+	if r.CreateContext != nil {
+		t.Error("expected the operations not implemented to be left unset")
+	}
+
+	diags := r.ReadContext(context.Background(), nil, nil)
+	if !strings.Contains(diags[0].Detail, "Request ID: abc") {
+		t.Errorf("expected the read errors to be detailed, got %q", diags[0].Detail)
+	}
+}
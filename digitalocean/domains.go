@@ -3,11 +3,16 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// recordMinimumTTL is the lowest TTL accepted by DigitalOcean DNS, lower
+// values are rounded up by the API.
+const recordMinimumTTL = 30
+
 func domainSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
@@ -72,3 +77,27 @@ func flattenDigitalOceanDomain(rawDomain, meta interface{}, extra map[string]int
 
 	return flattenedDomain, nil
 }
+
+// normalizeRecordTTL returns the TTL stored by the API for the given one.
+func normalizeRecordTTL(ttl int) int {
+	if ttl < recordMinimumTTL {
+		return recordMinimumTTL
+	}
+
+	return ttl
+}
+
+// suppressNormalizedTTLDiff ignores the difference between a configured TTL
+// and the value it is rounded to by the API.
+func suppressNormalizedTTLDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldTTL, err := strconv.Atoi(old)
+	if err != nil {
+		return false
+	}
+	newTTL, err := strconv.Atoi(new)
+	if err != nil {
+		return false
+	}
+
+	return oldTTL == normalizeRecordTTL(newTTL)
+}
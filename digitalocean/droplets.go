@@ -116,34 +116,20 @@ func dropletSchema() map[string]*schema.Schema {
 func getDigitalOceanDroplets(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	client := meta.(*CombinedConfig).godoClient()
 
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
-	}
-
-	var dropletList []interface{}
-
-	for {
-		droplets, resp, err := client.Droplets.List(context.Background(), opts)
-
+	dropletList, err := listAllPages(context.Background(), func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		droplets, resp, err := client.Droplets.List(ctx, opts)
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving droplets: %s", err)
-		}
-
-		for _, droplet := range droplets {
-			dropletList = append(dropletList, droplet)
+			return nil, resp, err
 		}
 
-		if resp.Links == nil || resp.Links.IsLastPage() {
-			break
+		items := make([]interface{}, len(droplets))
+		for i, droplet := range droplets {
+			items[i] = droplet
 		}
-
-		page, err := resp.Links.CurrentPage()
-		if err != nil {
-			return nil, fmt.Errorf("Error retrieving droplets: %s", err)
-		}
-
-		opts.Page = page + 1
+		return items, resp, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving droplets: %s", err)
 	}
 
 	return dropletList, nil
@@ -1,6 +1,12 @@
 package digitalocean
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,12 +30,14 @@ func firewallSchema() map[string]*schema.Schema {
 			Type:     schema.TypeSet,
 			Optional: true,
 			Elem:     firewallRuleSchema("source"),
+			Set:      hashFirewallInboundRule,
 		},
 
 		"outbound_rule": {
 			Type:     schema.TypeSet,
 			Optional: true,
 			Elem:     firewallRuleSchema("destination"),
+			Set:      hashFirewallOutboundRule,
 		},
 
 		"status": {
@@ -109,11 +117,149 @@ func firewallRuleSchema(prefix string) *schema.Resource {
 				},
 				Optional: true,
 			},
+			prefix + "kubernetes_ids": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Optional: true,
+			},
 			prefix + "tags": tagsSchema(),
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  "a human-readable description of the rule, only stored in the Terraform state",
+			},
 		},
 	}
 }
 
+// firewallRuleForceNewSchema returns the schema of a single firewall rule
+// where changing any of its fields replaces the rule.
+func firewallRuleForceNewSchema(prefix string) *schema.Resource {
+	rule := firewallRuleSchema(prefix)
+	for _, v := range rule.Schema {
+		v.ForceNew = true
+	}
+
+	return rule
+}
+
+// firewallRuleKey builds a canonical representation of a firewall rule so
+// that rules can be compared regardless of the ordering of their targets.
+func firewallRuleKey(protocol, portRange string, dropletIDs []int, addresses, loadBalancerUIDs, kubernetesIDs, tags []string) string {
+	// The API returns 0 when the port range was specified as all.
+	if portRange == "all" || portRange == "" {
+		portRange = "0"
+	}
+
+	droplets := make([]string, 0, len(dropletIDs))
+	for _, id := range dropletIDs {
+		droplets = append(droplets, strconv.Itoa(id))
+	}
+
+	parts := []string{protocol, portRange}
+	for _, values := range [][]string{droplets, addresses, loadBalancerUIDs, kubernetesIDs, tags} {
+		sorted := append([]string{}, values...)
+		sort.Strings(sorted)
+		parts = append(parts, strings.Join(sorted, ","))
+	}
+
+	return strings.Join(parts, "/")
+}
+
+func firewallInboundRuleKey(rule godo.InboundRule) string {
+	src := rule.Sources
+	if src == nil {
+		src = &godo.Sources{}
+	}
+
+	return fmt.Sprintf("inbound/%s", firewallRuleKey(rule.Protocol, rule.PortRange, src.DropletIDs, src.Addresses, src.LoadBalancerUIDs, src.KubernetesIDs, src.Tags))
+}
+
+func firewallOutboundRuleKey(rule godo.OutboundRule) string {
+	dest := rule.Destinations
+	if dest == nil {
+		dest = &godo.Destinations{}
+	}
+
+	return fmt.Sprintf("outbound/%s", firewallRuleKey(rule.Protocol, rule.PortRange, dest.DropletIDs, dest.Addresses, dest.LoadBalancerUIDs, dest.KubernetesIDs, dest.Tags))
+}
+
+// firewallRuleMapKey builds the canonical key of a firewall rule from its
+// schema representation, see firewallRuleKey.
+func firewallRuleMapKey(rule map[string]interface{}, prefix string) string {
+	protocol, _ := rule["protocol"].(string)
+	portRange, _ := rule["port_range"].(string)
+
+	var dropletIDs []int
+	if v, ok := rule[prefix+"droplet_ids"].(*schema.Set); ok {
+		dropletIDs = expandFirewallDropletIds(v.List())
+	}
+
+	var addresses, loadBalancerUIDs, kubernetesIDs, tags []string
+	if v, ok := rule[prefix+"addresses"].(*schema.Set); ok {
+		addresses = expandFirewallRuleStringSet(v.List())
+	}
+	if v, ok := rule[prefix+"load_balancer_uids"].(*schema.Set); ok {
+		loadBalancerUIDs = expandFirewallRuleStringSet(v.List())
+	}
+	if v, ok := rule[prefix+"kubernetes_ids"].(*schema.Set); ok {
+		kubernetesIDs = expandFirewallRuleStringSet(v.List())
+	}
+	if v, ok := rule[prefix+"tags"].(*schema.Set); ok {
+		tags = expandFirewallRuleStringSet(v.List())
+	}
+
+	return firewallRuleKey(protocol, portRange, dropletIDs, addresses, loadBalancerUIDs, kubernetesIDs, tags)
+}
+
+// hashFirewallInboundRule hashes inbound rules by their canonical key so that
+// equivalent rules are not shown as changes regardless of how they are ordered
+// or how their port range is spelled.
+func hashFirewallInboundRule(v interface{}) int {
+	rule := v.(map[string]interface{})
+	description, _ := rule["description"].(string)
+
+	return SDKHashString(fmt.Sprintf("%s/%s", firewallRuleMapKey(rule, "source_"), description))
+}
+
+// hashFirewallOutboundRule is the outbound counterpart of
+// hashFirewallInboundRule.
+func hashFirewallOutboundRule(v interface{}) int {
+	rule := v.(map[string]interface{})
+	description, _ := rule["description"].(string)
+
+	return SDKHashString(fmt.Sprintf("%s/%s", firewallRuleMapKey(rule, "destination_"), description))
+}
+
+// setFirewallRuleDescriptions copies the descriptions of the configured rules
+// onto the flattened rules returned by the API, which does not store them.
+func setFirewallRuleDescriptions(rules []interface{}, configured *schema.Set, prefix string) []interface{} {
+	if configured == nil {
+		return rules
+	}
+
+	descriptions := make(map[string]string, configured.Len())
+	for _, v := range configured.List() {
+		rule := v.(map[string]interface{})
+		if description, _ := rule["description"].(string); description != "" {
+			descriptions[firewallRuleMapKey(rule, prefix)] = description
+		}
+	}
+
+	for _, v := range rules {
+		rule := v.(map[string]interface{})
+		if description, ok := descriptions[firewallRuleMapKey(rule, prefix)]; ok {
+			rule["description"] = description
+		}
+	}
+
+	return rules
+}
+
 func expandFirewallDropletIds(droplets []interface{}) []int {
 	expandedDroplets := make([]int, len(droplets))
 	for i, v := range droplets {
@@ -145,6 +291,8 @@ func expandFirewallInboundRules(rules []interface{}) []godo.InboundRule {
 
 		src.LoadBalancerUIDs = expandFirewallRuleStringSet(rule["source_load_balancer_uids"].(*schema.Set).List())
 
+		src.KubernetesIDs = expandFirewallRuleStringSet(rule["source_kubernetes_ids"].(*schema.Set).List())
+
 		src.Tags = expandTags(rule["source_tags"].(*schema.Set).List())
 
 		r := godo.InboundRule{
@@ -171,6 +319,8 @@ func expandFirewallOutboundRules(rules []interface{}) []godo.OutboundRule {
 
 		dest.LoadBalancerUIDs = expandFirewallRuleStringSet(rule["destination_load_balancer_uids"].(*schema.Set).List())
 
+		dest.KubernetesIDs = expandFirewallRuleStringSet(rule["destination_kubernetes_ids"].(*schema.Set).List())
+
 		dest.Tags = expandTags(rule["destination_tags"].(*schema.Set).List())
 
 		r := godo.OutboundRule{
@@ -261,6 +411,10 @@ func flattenFirewallInboundRules(rules []godo.InboundRule) []interface{} {
 			rawRule["source_load_balancer_uids"] = flattenFirewallRuleStringSet(sources.LoadBalancerUIDs)
 		}
 
+		if sources.KubernetesIDs != nil {
+			rawRule["source_kubernetes_ids"] = flattenFirewallRuleStringSet(sources.KubernetesIDs)
+		}
+
 		flattenedRules[i] = rawRule
 	}
 
@@ -309,8 +463,116 @@ func flattenFirewallOutboundRules(rules []godo.OutboundRule) []interface{} {
 			rawRule["destination_load_balancer_uids"] = flattenFirewallRuleStringSet(destinations.LoadBalancerUIDs)
 		}
 
+		if destinations.KubernetesIDs != nil {
+			rawRule["destination_kubernetes_ids"] = flattenFirewallRuleStringSet(destinations.KubernetesIDs)
+		}
+
 		flattenedRules[i] = rawRule
 	}
 
 	return flattenedRules
 }
+
+func firewallListSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "ID of the firewall",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the firewall",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "status of the firewall",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the firewall was created",
+		},
+		"droplet_ids": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+			Description: "IDs of the Droplets the firewall is applied to",
+		},
+		"tags": tagsDataSourceSchema(),
+		"inbound_rule": {
+			Type:        schema.TypeList,
+			Elem:        firewallRuleSchema("source"),
+			Description: "inbound rules of the firewall",
+		},
+		"outbound_rule": {
+			Type:        schema.TypeList,
+			Elem:        firewallRuleSchema("destination"),
+			Description: "outbound rules of the firewall",
+		},
+	}
+}
+
+func getDigitalOceanFirewalls(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var firewallList []interface{}
+
+	for {
+		firewalls, resp, err := client.Firewalls.List(context.Background(), opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving firewalls: %s", err)
+		}
+
+		for _, firewall := range firewalls {
+			firewallList = append(firewallList, firewall)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving firewalls: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return firewallList, nil
+}
+
+func flattenDigitalOceanFirewall(rawFirewall, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	firewall, ok := rawFirewall.(godo.Firewall)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert to godo.Firewall")
+	}
+
+	// Filtering requires every set to be present, even when empty.
+	dropletIDs := schema.NewSet(schema.HashInt, []interface{}{})
+	for _, id := range firewall.DropletIDs {
+		dropletIDs.Add(id)
+	}
+
+	tags := flattenTags(firewall.Tags)
+	if tags == nil {
+		tags = schema.NewSet(HashStringIgnoreCase, []interface{}{})
+	}
+
+	flattenedFirewall := map[string]interface{}{
+		"id":            firewall.ID,
+		"name":          firewall.Name,
+		"status":        firewall.Status,
+		"created_at":    firewall.Created,
+		"droplet_ids":   dropletIDs,
+		"tags":          tags,
+		"inbound_rule":  flattenFirewallInboundRules(firewall.InboundRules),
+		"outbound_rule": flattenFirewallOutboundRules(firewall.OutboundRules),
+	}
+
+	return flattenedFirewall, nil
+}
@@ -0,0 +1,227 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/mitchellh/go-homedir"
+)
+
+// imageStagingPartSize is the size of the parts the images larger than it
+// are uploaded in. Spaces accepts at most 10,000 parts per object.
+var imageStagingPartSize int64 = 100 * 1024 * 1024
+
+const (
+	// imageStagingMaxExpiry is the longest a presigned URL can be valid for.
+	imageStagingMaxExpiry = 7 * 24 * time.Hour
+
+	// imageStagingCleanupTimeout bounds the deletion of the staged image and
+	// its temporary bucket.
+	imageStagingCleanupTimeout = 2 * time.Minute
+)
+
+// stagedImage is a local image uploaded to Spaces for the API to import it.
+type stagedImage struct {
+	svc           *s3.S3
+	bucket        string
+	key           string
+	createdBucket bool
+
+	// url is a presigned URL the image can be downloaded from.
+	url string
+}
+
+// stageImage uploads the image at path to bucket, or to a temporary bucket
+// created in region when bucket is empty. The returned URL stays valid for
+// expiry. If checksum is set, the image is only uploaded if it matches.
+func stageImage(ctx context.Context, meta interface{}, path, checksum, bucket, region string, expiry time.Duration) (*stagedImage, error) {
+	config := meta.(*CombinedConfig)
+
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding homedir in source (%s): %s", path, err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Error closing custom image source (%s): %s", path, err)
+		}
+	}()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if checksum != "" {
+		algorithm, expected, err := parseImageChecksum(checksum)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkImageChecksum(file, algorithm, expected); err != nil {
+			return nil, err
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	region, err = config.spacesRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := config.spacesClient(region)
+	if err != nil {
+		return nil, err
+	}
+
+	staged := &stagedImage{
+		svc:    s3.New(sess),
+		bucket: bucket,
+		key:    fmt.Sprintf("terraform-custom-images/%s/%s", resource.UniqueId(), filepath.Base(path)),
+	}
+
+	if staged.bucket == "" {
+		staged.bucket = resource.PrefixedUniqueId("tf-custom-image-")
+
+		log.Printf("[INFO] Creating temporary Spaces bucket %s in %s", staged.bucket, region)
+		_, err := staged.svc.CreateBucketWithContext(ctx, &s3.CreateBucketInput{
+			Bucket: aws.String(staged.bucket),
+			ACL:    aws.String(s3.BucketCannedACLPrivate),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating temporary Spaces bucket %s: %s", staged.bucket, err)
+		}
+		staged.createdBucket = true
+	}
+
+	log.Printf("[INFO] Uploading custom image %s (%d bytes) to %s/%s", path, info.Size(), staged.bucket, staged.key)
+	if err := staged.upload(ctx, file, info.Size()); err != nil {
+		err = fmt.Errorf("error uploading %s to Spaces bucket %s: %s", path, staged.bucket, err)
+		if cleanupErr := staged.cleanup(); cleanupErr != nil {
+			err = fmt.Errorf("%s, and %s", err, cleanupErr)
+		}
+		return nil, err
+	}
+
+	if expiry > imageStagingMaxExpiry {
+		expiry = imageStagingMaxExpiry
+	}
+	req, _ := staged.svc.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(staged.bucket),
+		Key:    aws.String(staged.key),
+	})
+	staged.url, err = req.Presign(expiry)
+	if err != nil {
+		err = fmt.Errorf("error presigning the URL of %s/%s: %s", staged.bucket, staged.key, err)
+		if cleanupErr := staged.cleanup(); cleanupErr != nil {
+			err = fmt.Errorf("%s, and %s", err, cleanupErr)
+		}
+		return nil, err
+	}
+
+	return staged, nil
+}
+
+// upload uploads the size bytes of r, in parts if it is larger than
+// imageStagingPartSize.
+func (s *stagedImage) upload(ctx context.Context, r io.ReaderAt, size int64) error {
+	if size <= imageStagingPartSize {
+		_, err := s.svc.PutObjectWithContext(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(s.key),
+			ACL:    aws.String(s3.ObjectCannedACLPrivate),
+			Body:   io.NewSectionReader(r, 0, size),
+		})
+		return err
+	}
+
+	upload, err := s.svc.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key),
+		ACL:    aws.String(s3.ObjectCannedACLPrivate),
+	})
+	if err != nil {
+		return err
+	}
+
+	var parts []*s3.CompletedPart
+	for number, offset := int64(1), int64(0); offset < size; number, offset = number+1, offset+imageStagingPartSize {
+		length := imageStagingPartSize
+		if remaining := size - offset; remaining < length {
+			length = remaining
+		}
+
+		log.Printf("[DEBUG] Uploading part %d of %s/%s", number, s.bucket, s.key)
+		part, err := s.svc.UploadPartWithContext(ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(s.bucket),
+			Key:        aws.String(s.key),
+			UploadId:   upload.UploadId,
+			PartNumber: aws.Int64(number),
+			Body:       io.NewSectionReader(r, offset, length),
+		})
+		if err != nil {
+			_, abortErr := s.svc.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(s.bucket),
+				Key:      aws.String(s.key),
+				UploadId: upload.UploadId,
+			})
+			if abortErr != nil {
+				log.Printf("[WARN] Error aborting the upload of %s/%s: %s", s.bucket, s.key, abortErr)
+			}
+			return err
+		}
+
+		parts = append(parts, &s3.CompletedPart{
+			ETag:       part.ETag,
+			PartNumber: aws.Int64(number),
+		})
+	}
+
+	_, err = s.svc.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(s.bucket),
+		Key:             aws.String(s.key),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	return err
+}
+
+// cleanup deletes the uploaded image, and the bucket if it was created for
+// it. It usually runs once the context of the operation expired, e.g. when
+// waiting for the import timed out, so it uses a context of its own.
+func (s *stagedImage) cleanup() error {
+	ctx, cancel := context.WithTimeout(context.Background(), imageStagingCleanupTimeout)
+	defer cancel()
+
+	log.Printf("[INFO] Deleting staged custom image %s/%s", s.bucket, s.key)
+	_, err := s.svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key),
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting staged custom image %s/%s: %s", s.bucket, s.key, err)
+	}
+
+	if !s.createdBucket {
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting temporary Spaces bucket %s", s.bucket)
+	if _, err := s.svc.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(s.bucket)}); err != nil {
+		return fmt.Errorf("error deleting temporary Spaces bucket %s: %s", s.bucket, err)
+	}
+
+	return nil
+}
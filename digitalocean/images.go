@@ -2,8 +2,19 @@ package digitalocean
 
 import (
 	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
 	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -79,37 +90,83 @@ func imageSchema() map[string]*schema.Schema {
 
 func getDigitalOceanImages(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
 	client := meta.(*CombinedConfig).godoClient()
-	return listDigitalOceanImages(client.Images.List)
+
+	images, err := listDigitalOceanImages(client.Images.List)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterDigitalOceanImages(images, extra)
 }
 
-func listDigitalOceanImages(listImages imageListFunc) ([]interface{}, error) {
-	var allImages []interface{}
+// filterDigitalOceanImages keeps the images matching the region, disk_size,
+// created_after and created_before arguments of the images data source, the
+// ones left unset match every image.
+func filterDigitalOceanImages(images []interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	region, _ := extra["region"].(string)
+	diskSize, _ := extra["disk_size"].(int)
 
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
+	var createdAfter, createdBefore time.Time
+	if v, _ := extra["created_after"].(string); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid created_after: %s", err)
+		}
+		createdAfter = t
 	}
-
-	for {
-		images, resp, err := listImages(context.Background(), opts)
+	if v, _ := extra["created_before"].(string); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving images: %s", err)
+			return nil, fmt.Errorf("invalid created_before: %s", err)
 		}
+		createdBefore = t
+	}
+
+	var filtered []interface{}
+	for _, item := range images {
+		image := item.(godo.Image)
 
-		for _, image := range images {
-			allImages = append(allImages, image)
+		if region != "" && !stringInSlice(region, image.Regions) {
+			continue
+		}
+		if diskSize > 0 && image.MinDiskSize > diskSize {
+			continue
 		}
 
-		if resp.Links == nil || resp.Links.IsLastPage() {
-			break
+		if !createdAfter.IsZero() || !createdBefore.IsZero() {
+			created, err := time.Parse(time.RFC3339, image.Created)
+			if err != nil {
+				continue
+			}
+			if !createdAfter.IsZero() && !created.After(createdAfter) {
+				continue
+			}
+			if !createdBefore.IsZero() && !created.Before(createdBefore) {
+				continue
+			}
 		}
 
-		page, err := resp.Links.CurrentPage()
+		filtered = append(filtered, item)
+	}
+
+	return filtered, nil
+}
+
+func listDigitalOceanImages(listImages imageListFunc) ([]interface{}, error) {
+	allImages, err := listAllPages(context.Background(), func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		images, resp, err := listImages(ctx, opts)
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving images: %s", err)
+			return nil, resp, err
 		}
 
-		opts.Page = page + 1
+		items := make([]interface{}, len(images))
+		for i, image := range images {
+			items[i] = image
+		}
+		return items, resp, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving images: %s", err)
 	}
 
 	return allImages, nil
@@ -150,3 +207,96 @@ func flattenDigitalOceanImage(rawImage interface{}, meta interface{}, extra map[
 
 	return flattenedImage, nil
 }
+
+// imageChecksumHashes are the algorithms the checksum of a custom image can be
+// computed with.
+var imageChecksumHashes = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// parseImageChecksum splits a checksum of the form <algorithm>:<hex digest>.
+func parseImageChecksum(checksum string) (string, string, error) {
+	parts := strings.SplitN(checksum, ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("checksum must be of the form <algorithm>:<hex digest>, got %q", checksum)
+	}
+
+	algorithm, digest := strings.ToLower(parts[0]), strings.ToLower(parts[1])
+	newHash, ok := imageChecksumHashes[algorithm]
+	if !ok {
+		var algorithms []string
+		for a := range imageChecksumHashes {
+			algorithms = append(algorithms, a)
+		}
+		sort.Strings(algorithms)
+		return "", "", fmt.Errorf("checksum algorithm must be one of %s, got %q", strings.Join(algorithms, ", "), parts[0])
+	}
+
+	if b, err := hex.DecodeString(digest); err != nil || len(b) != newHash().Size() {
+		return "", "", fmt.Errorf("checksum digest must be %d hexadecimal characters for %s, got %q", 2*newHash().Size(), algorithm, parts[1])
+	}
+
+	return algorithm, digest, nil
+}
+
+func validateImageChecksum(v interface{}, k string) ([]string, []error) {
+	if _, _, err := parseImageChecksum(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %s", k, err)}
+	}
+	return nil, nil
+}
+
+// verifyImageChecksum downloads the image at url and checks that it matches
+// checksum, so that an image that was changed or corrupted is not imported.
+func verifyImageChecksum(ctx context.Context, meta interface{}, url, checksum string) error {
+	algorithm, expected, err := parseImageChecksum(checksum)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", meta.(*CombinedConfig).userAgent)
+
+	resp, err := meta.(*CombinedConfig).httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response downloading the image: %s", resp.Status)
+	}
+
+	return checkImageChecksum(resp.Body, algorithm, expected)
+}
+
+// checkImageChecksum checks that the hex digest of r computed with algorithm
+// is expected.
+func checkImageChecksum(r io.Reader, algorithm, expected string) error {
+	h := imageChecksumHashes[algorithm]()
+	if _, err := io.Copy(h, r); err != nil {
+		return fmt.Errorf("error downloading the image: %s", err)
+	}
+
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+		return fmt.Errorf("the image has checksum %s:%s, expected %s:%s", algorithm, actual, algorithm, expected)
+	}
+
+	return nil
+}
+
+// imageImportError returns the reason an image failed to be imported.
+func imageImportError(image *godo.Image) error {
+	if image.ErrorMessage != "" {
+		return fmt.Errorf("import of image (%d) failed: %s", image.ID, image.ErrorMessage)
+	}
+
+	return fmt.Errorf("import of image (%d) failed without a reason, check that its URL is publicly reachable and "+
+		"that it is a raw, qcow2, vhdx, vdi or vmdk image, optionally compressed with gzip or bzip2", image.ID)
+}
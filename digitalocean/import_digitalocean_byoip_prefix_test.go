@@ -0,0 +1,31 @@
+package digitalocean
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanBYOIPPrefix_importBasic(t *testing.T) {
+	resourceName := "digitalocean_byoip_prefix.foobar"
+	cidr, signature := testAccPreCheckBYOIPPrefix(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBYOIPPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanBYOIPPrefixConfig_basic(cidr, signature, false),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API does not return the signature the prefix was
+				// validated with.
+				ImportStateVerifyIgnore: []string{"signature"},
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanCustomImage_importBasic(t *testing.T) {
+	rString := randomTestName()
+	resourceName := fmt.Sprintf("digitalocean_custom_image.%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCustomImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanCustomImageConfig(rString, rString, `["nyc3"]`, "Unknown"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API does not return the URL the image was imported from.
+				ImportStateVerifyIgnore: []string{"url"},
+			},
+		},
+	})
+}
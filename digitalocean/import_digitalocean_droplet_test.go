@@ -89,7 +89,7 @@ func takeDropletSnapshot(rInt int, droplet *godo.Droplet, snapshotId *[]int) res
 		if err != nil {
 			return err
 		}
-		waitForAction(client, action)
+		waitForAction(context.Background(), testAccProvider.Meta(), action)
 
 		retrieveDroplet, _, err := client.Droplets.Get(context.Background(), (*droplet).ID)
 		if err != nil {
@@ -0,0 +1,48 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanProjectResources_importBasic(t *testing.T) {
+	resourceName := "digitalocean_project_resources.barfoo"
+	projectName := generateProjectName()
+	dropletName := generateDropletName()
+
+	config := fmt.Sprintf(`
+resource "digitalocean_project" "foo" {
+  name = "%s"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-20-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_project_resources" "barfoo" {
+  project   = digitalocean_project.foo.id
+  resources = [digitalocean_droplet.foobar.urn]
+}
+`, projectName, dropletName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanProjectResourcesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
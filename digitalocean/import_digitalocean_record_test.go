@@ -41,3 +41,32 @@ func TestAccDigitalOceanRecord_importBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDigitalOceanRecord_importByNameAndType(t *testing.T) {
+	resourceName := "digitalocean_record.foobar"
+	domainName := fmt.Sprintf("foobar-test-terraform-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanRecordConfig_basic, domainName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s,terraform,A", domainName),
+			},
+			// Test importing a record which does not exist provides expected error.
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s,nonexistent,A", domainName),
+				ExpectError:   regexp.MustCompile(`no A record named nonexistent found`),
+			},
+		},
+	})
+}
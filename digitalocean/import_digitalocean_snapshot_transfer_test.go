@@ -0,0 +1,28 @@
+package digitalocean
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanSnapshotTransfer_importBasic(t *testing.T) {
+	name := randomTestName()
+	resourceName := "digitalocean_snapshot_transfer.foobar"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanSnapshotTransferConfig_basic(name, `["nyc3", "sfo3"]`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanSpacesBucketObject_importBasic(t *testing.T) {
+	resourceName := "digitalocean_spaces_bucket_object.object"
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanSpacesBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanSpacesBucketObjectConfigContent(rInt, "some_bucket_content"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return fmt.Sprintf("%s,%s,%s", rs.Primary.Attributes["region"], rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"]), nil
+				},
+				// The content of objects is not read back.
+				ImportStateVerifyIgnore: []string{"content", "force_destroy"},
+			},
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package digitalocean
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanTagAssignment_importBasic(t *testing.T) {
+	resourceName := "digitalocean_tag_assignment.foobar"
+	tagName := randomTestName()
+	volumeName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanTagAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanTagAssignmentConfig_basic(tagName, volumeName),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "foobar",
+				ExpectError:   regexp.MustCompile("must use the tag and the URN of the resource joined with a comma"),
+			},
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanVolumeAttachment_importBasic(t *testing.T) {
+	resourceName := "digitalocean_volume_attachment.foobar"
+	volume := godo.Volume{Name: fmt.Sprintf("volume-%s", acctest.RandString(10))}
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanVolumeAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanVolumeAttachmentConfig_basic(rInt, volume.Name),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				// The ID of volume attachments is generated, it differs
+				// after the import.
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return fmt.Sprintf("%s,%s", rs.Primary.Attributes["droplet_id"], rs.Primary.Attributes["volume_id"]), nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("Expected 1 state, got %d", len(states))
+					}
+					if states[0].Attributes["droplet_id"] == "" || states[0].Attributes["volume_id"] == "" {
+						return fmt.Errorf("Expected droplet_id and volume_id to be set, got %v", states[0].Attributes)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"strings"
 
 	"github.com/digitalocean/godo"
@@ -216,3 +218,263 @@ func flattenForwardingRules(client *godo.Client, rules []godo.ForwardingRule) ([
 
 	return result, nil
 }
+
+const (
+	loadBalancersBasePath = "/v2/load_balancers"
+	loadBalancerPath      = loadBalancersBasePath + "/%s"
+
+	loadBalancerTypeGlobal = "GLOBAL"
+
+	loadBalancerNetworkExternal = "EXTERNAL"
+	loadBalancerNetworkInternal = "INTERNAL"
+)
+
+// loadBalancerDomain is a domain served by a global load balancer.
+type loadBalancerDomain struct {
+	Name          string `json:"name"`
+	IsManaged     bool   `json:"is_managed"`
+	CertificateID string `json:"certificate_id,omitempty"`
+}
+
+type globalLoadBalancerCDNSettings struct {
+	IsEnabled bool `json:"is_enabled"`
+}
+
+// globalLoadBalancerSettings configures how a global load balancer forwards
+// traffic to its targets.
+type globalLoadBalancerSettings struct {
+	TargetProtocol    string                         `json:"target_protocol"`
+	TargetPort        int                            `json:"target_port"`
+	CDN               *globalLoadBalancerCDNSettings `json:"cdn,omitempty"`
+	RegionPriorities  map[string]int                 `json:"region_priorities,omitempty"`
+	FailoverThreshold int                            `json:"failover_threshold,omitempty"`
+}
+
+// loadBalancerFirewall restricts the sources allowed to connect to a load
+// balancer. Rules take the form of "ip:1.2.3.4" or "cidr:1.2.0.0/16".
+type loadBalancerFirewall struct {
+	Allow []string `json:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty"`
+}
+
+// loadBalancer extends godo.LoadBalancer with the settings godo does not
+// model.
+type loadBalancer struct {
+	godo.LoadBalancer
+	Type                  string                      `json:"type,omitempty"`
+	Domains               []*loadBalancerDomain       `json:"domains,omitempty"`
+	GLBSettings           *globalLoadBalancerSettings `json:"glb_settings,omitempty"`
+	TargetLoadBalancerIDs []string                    `json:"target_load_balancer_ids,omitempty"`
+	Firewall              *loadBalancerFirewall       `json:"firewall,omitempty"`
+	Network               string                      `json:"network,omitempty"`
+	SizeUnit              int                         `json:"size_unit,omitempty"`
+	HTTPIdleTimeout       int                         `json:"http_idle_timeout_seconds,omitempty"`
+}
+
+// loadBalancerRequest extends godo.LoadBalancerRequest with the settings godo
+// does not model.
+type loadBalancerRequest struct {
+	godo.LoadBalancerRequest
+	Type                  string                      `json:"type,omitempty"`
+	Domains               []*loadBalancerDomain       `json:"domains,omitempty"`
+	GLBSettings           *globalLoadBalancerSettings `json:"glb_settings,omitempty"`
+	TargetLoadBalancerIDs []string                    `json:"target_load_balancer_ids,omitempty"`
+	Firewall              *loadBalancerFirewall       `json:"firewall,omitempty"`
+	Network               string                      `json:"network,omitempty"`
+	SizeUnit              int                         `json:"size_unit,omitempty"`
+	HTTPIdleTimeout       int                         `json:"http_idle_timeout_seconds,omitempty"`
+}
+
+type loadBalancerRoot struct {
+	LoadBalancer *loadBalancer `json:"load_balancer"`
+}
+
+type loadBalancersRoot struct {
+	LoadBalancers []*loadBalancer `json:"load_balancers"`
+	Links         *godo.Links     `json:"links"`
+}
+
+// createLoadBalancer creates a load balancer including the settings godo does
+// not model.
+func createLoadBalancer(ctx context.Context, client *godo.Client, createRequest *loadBalancerRequest) (*loadBalancer, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodPost, loadBalancersBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(loadBalancerRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.LoadBalancer, resp, nil
+}
+
+// getLoadBalancer retrieves a load balancer including the settings godo does
+// not model.
+func getLoadBalancer(ctx context.Context, client *godo.Client, lbID string) (*loadBalancer, *godo.Response, error) {
+	path := fmt.Sprintf(loadBalancerPath, lbID)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(loadBalancerRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.LoadBalancer, resp, nil
+}
+
+// updateLoadBalancer replaces the configuration of a load balancer including
+// the settings godo does not model.
+func updateLoadBalancer(ctx context.Context, client *godo.Client, lbID string, updateRequest *loadBalancerRequest) (*loadBalancer, *godo.Response, error) {
+	path := fmt.Sprintf(loadBalancerPath, lbID)
+	req, err := client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(loadBalancerRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.LoadBalancer, resp, nil
+}
+
+// listLoadBalancers lists the load balancers of the account including the
+// settings godo does not model.
+func listLoadBalancers(ctx context.Context, client *godo.Client) ([]*loadBalancer, error) {
+	items, err := listAllPages(ctx, func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		path := fmt.Sprintf("%s?page=%d&per_page=%d", loadBalancersBasePath, opts.Page, opts.PerPage)
+		req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		root := new(loadBalancersRoot)
+		resp, err := client.Do(ctx, req, root)
+		if err != nil {
+			return nil, resp, err
+		}
+		if root.Links != nil {
+			resp.Links = root.Links
+		}
+
+		items := make([]interface{}, len(root.LoadBalancers))
+		for i, lb := range root.LoadBalancers {
+			items[i] = lb
+		}
+		return items, resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lbs := make([]*loadBalancer, len(items))
+	for i, item := range items {
+		lbs[i] = item.(*loadBalancer)
+	}
+	return lbs, nil
+}
+
+// loadBalancerAsRequest returns the request replacing the configuration of a
+// load balancer with its current one, to be changed before being sent.
+func loadBalancerAsRequest(lb *loadBalancer) *loadBalancerRequest {
+	req := &loadBalancerRequest{
+		LoadBalancerRequest: godo.LoadBalancerRequest{
+			Name:                   lb.Name,
+			Algorithm:              lb.Algorithm,
+			HealthCheck:            lb.HealthCheck,
+			StickySessions:         lb.StickySessions,
+			Tags:                   lb.Tags,
+			RedirectHttpToHttps:    lb.RedirectHttpToHttps,
+			EnableProxyProtocol:    lb.EnableProxyProtocol,
+			EnableBackendKeepalive: lb.EnableBackendKeepalive,
+			VPCUUID:                lb.VPCUUID,
+			ForwardingRules:        lb.ForwardingRules,
+		},
+		Type:                  lb.Type,
+		Domains:               lb.Domains,
+		GLBSettings:           lb.GLBSettings,
+		TargetLoadBalancerIDs: lb.TargetLoadBalancerIDs,
+		Firewall:              lb.Firewall,
+		Network:               lb.Network,
+		HTTPIdleTimeout:       lb.HTTPIdleTimeout,
+	}
+
+	if lb.Region != nil {
+		req.Region = lb.Region.Slug
+	}
+
+	// The API only accepts one of the two ways to size a load balancer.
+	if lb.SizeUnit > 0 {
+		req.SizeUnit = lb.SizeUnit
+	} else {
+		req.SizeSlug = lb.SizeSlug
+	}
+
+	if lb.Tag != "" {
+		req.Tag = lb.Tag
+	} else {
+		req.DropletIDs = lb.DropletIDs
+	}
+
+	return req
+}
+
+func expandLoadBalancerFirewall(config []interface{}) *loadBalancerFirewall {
+	firewall := &loadBalancerFirewall{}
+
+	if len(config) > 0 && config[0] != nil {
+		firewallConfig := config[0].(map[string]interface{})
+
+		for _, rule := range firewallConfig["allow"].([]interface{}) {
+			firewall.Allow = append(firewall.Allow, rule.(string))
+		}
+
+		for _, rule := range firewallConfig["deny"].([]interface{}) {
+			firewall.Deny = append(firewall.Deny, rule.(string))
+		}
+	}
+
+	return firewall
+}
+
+func flattenLoadBalancerFirewall(firewall *loadBalancerFirewall) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, 1)
+
+	if firewall != nil && (len(firewall.Allow) > 0 || len(firewall.Deny) > 0) {
+		r := make(map[string]interface{})
+		r["allow"] = firewall.Allow
+		r["deny"] = firewall.Deny
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func validateLoadBalancerFirewallRule(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	switch {
+	case strings.HasPrefix(value, "ip:"):
+		if net.ParseIP(strings.TrimPrefix(value, "ip:")) == nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid IP address: %q", k, value))
+		}
+	case strings.HasPrefix(value, "cidr:"):
+		if _, _, err := net.ParseCIDR(strings.TrimPrefix(value, "cidr:")); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid CIDR block: %q", k, value))
+		}
+	default:
+		errors = append(errors, fmt.Errorf("%q must be of the form ip:<address> or cidr:<block>, got: %q", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,139 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func monitorAlertSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Description: "the uuid of the alert policy",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "the metric the alert policy is about",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Description: "description of the alert policy",
+		},
+		"compare": {
+			Type:        schema.TypeString,
+			Description: "the comparison operator used for value",
+		},
+		"value": {
+			Type:        schema.TypeFloat,
+			Description: "the value to start alerting at",
+		},
+		"window": {
+			Type:        schema.TypeString,
+			Description: "the time frame of the alert policy",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Description: "whether the alert policy is enabled",
+		},
+		"entities": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "the droplets the alert policy applies to",
+		},
+		"tags": tagsDataSourceSchema(),
+		"alerts": {
+			Type:        schema.TypeList,
+			Description: "how notifications about the alert policy are sent",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"slack": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"channel": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"url": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+					"email": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getDigitalOceanMonitorAlerts(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var alertList []interface{}
+
+	for {
+		alerts, resp, err := client.Monitoring.ListAlertPolicies(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving monitor alerts: %s", err)
+		}
+
+		for _, alert := range alerts {
+			alertList = append(alertList, alert)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving monitor alerts: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return alertList, nil
+}
+
+func flattenDigitalOceanMonitorAlert(rawAlert, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	alert, ok := rawAlert.(godo.AlertPolicy)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert to godo.AlertPolicy")
+	}
+
+	entities := schema.NewSet(schema.HashString, []interface{}{})
+	for _, entity := range alert.Entities {
+		entities.Add(entity)
+	}
+
+	flattenedAlert := map[string]interface{}{
+		"uuid":        alert.UUID,
+		"type":        alert.Type,
+		"description": alert.Description,
+		"compare":     string(alert.Compare),
+		"value":       float64(alert.Value),
+		"window":      alert.Window,
+		"enabled":     alert.Enabled,
+		"entities":    entities,
+		"tags":        flattenTags(alert.Tags),
+		"alerts":      flattenAlerts(alert.Alerts),
+	}
+
+	return flattenedAlert, nil
+}
@@ -0,0 +1,115 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+const dropletBandwidthMetricsPath = "/v2/monitoring/metrics/droplet/bandwidth"
+
+// metricsResponse is the Prometheus style matrix returned by the metrics
+// endpoints of the monitoring API.
+type metricsResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Values [][]interface{}   `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// metricsSample is the value of a metric at a point in time.
+type metricsSample struct {
+	Time  time.Time
+	Value float64
+}
+
+// getDropletBandwidthMetrics retrieves the bandwidth in Mbps of a network
+// interface of a Droplet in one direction between start and end.
+func getDropletBandwidthMetrics(ctx context.Context, client *godo.Client, dropletID int, iface, direction string, start, end time.Time) ([]metricsSample, *godo.Response, error) {
+	query := url.Values{}
+	query.Set("host_id", strconv.Itoa(dropletID))
+	query.Set("interface", iface)
+	query.Set("direction", direction)
+	query.Set("start", strconv.FormatInt(start.Unix(), 10))
+	query.Set("end", strconv.FormatInt(end.Unix(), 10))
+
+	req, err := client.NewRequest(ctx, http.MethodGet, dropletBandwidthMetricsPath+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(metricsResponse)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	samples, err := flattenMetricsSamples(root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return samples, resp, nil
+}
+
+// flattenMetricsSamples merges the series of a metrics response into samples
+// sorted by time. Values are sent as a [unix timestamp, "value"] pair.
+func flattenMetricsSamples(root *metricsResponse) ([]metricsSample, error) {
+	var samples []metricsSample
+
+	for _, result := range root.Data.Result {
+		for _, pair := range result.Values {
+			if len(pair) != 2 {
+				return nil, fmt.Errorf("unexpected metrics value %v", pair)
+			}
+
+			timestamp, ok := pair[0].(float64)
+			if !ok {
+				return nil, fmt.Errorf("unexpected metrics timestamp %v", pair[0])
+			}
+
+			rawValue, ok := pair[1].(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected metrics value %v", pair[1])
+			}
+			value, err := strconv.ParseFloat(rawValue, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected metrics value %q: %s", rawValue, err)
+			}
+
+			samples = append(samples, metricsSample{
+				Time:  time.Unix(int64(timestamp), 0).UTC(),
+				Value: value,
+			})
+		}
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool {
+		return samples[i].Time.Before(samples[j].Time)
+	})
+
+	return samples, nil
+}
+
+// bandwidthTransferredBytes estimates the bytes transferred from bandwidth
+// samples in Mbps, interpolating linearly between samples.
+func bandwidthTransferredBytes(samples []metricsSample) int {
+	total := 0.0
+	for i := 1; i < len(samples); i++ {
+		seconds := samples[i].Time.Sub(samples[i-1].Time).Seconds()
+		mbps := (samples[i].Value + samples[i-1].Value) / 2
+		total += mbps * 1000000 / 8 * seconds
+	}
+
+	return int(total)
+}
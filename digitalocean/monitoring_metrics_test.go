@@ -0,0 +1,89 @@
+package digitalocean
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestFlattenMetricsSamples(t *testing.T) {
+	raw := `{
+  "status": "success",
+  "data": {
+    "resultType": "matrix",
+    "result": [
+      {
+        "metric": {"direction": "inbound", "host_id": "123", "interface": "public"},
+        "values": [[1622505660, "2"], [1622505600, "0.5"]]
+      }
+    ]
+  }
+}`
+
+	root := new(metricsResponse)
+	if err := json.Unmarshal([]byte(raw), root); err != nil {
+		t.Fatal(err)
+	}
+
+	samples, err := flattenMetricsSamples(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(samples) != 2 {
+		t.Fatalf("expected 2 samples, got %d", len(samples))
+	}
+	if !samples[0].Time.Equal(time.Unix(1622505600, 0)) || samples[0].Value != 0.5 {
+		t.Fatalf("unexpected first sample %#v", samples[0])
+	}
+	if !samples[1].Time.Equal(time.Unix(1622505660, 0)) || samples[1].Value != 2 {
+		t.Fatalf("unexpected second sample %#v", samples[1])
+	}
+}
+
+func TestFlattenMetricsSamples_Invalid(t *testing.T) {
+	raw := `{"data": {"result": [{"values": [[1622505600, "not a number"]]}]}}`
+
+	root := new(metricsResponse)
+	if err := json.Unmarshal([]byte(raw), root); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := flattenMetricsSamples(root); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestBandwidthTransferredBytes(t *testing.T) {
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		samples  []metricsSample
+		expected int
+	}{
+		{samples: nil, expected: 0},
+		{samples: []metricsSample{{Time: start, Value: 8}}, expected: 0},
+		// 8 Mbps during a minute is 60 MB.
+		{
+			samples: []metricsSample{
+				{Time: start, Value: 8},
+				{Time: start.Add(time.Minute), Value: 8},
+			},
+			expected: 60000000,
+		},
+		// Linear from 0 to 16 Mbps averages 8 Mbps.
+		{
+			samples: []metricsSample{
+				{Time: start, Value: 0},
+				{Time: start.Add(time.Minute), Value: 16},
+			},
+			expected: 60000000,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := bandwidthTransferredBytes(tc.samples); actual != tc.expected {
+			t.Fatalf("expected %d bytes for %v, got %d", tc.expected, tc.samples, actual)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/digitalocean/godo"
+)
+
+// listPagesConcurrency is the maximum number of pages of a collection
+// fetched at the same time by listAllPages. The requests still go through
+// the rate limiting of the client.
+const listPagesConcurrency = 4
+
+// pageListFunc lists one page of a collection.
+type pageListFunc func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error)
+
+// listAllPages returns all of the items of a collection. The first page tells
+// how many pages there are, the other ones are fetched concurrently. The items
+// are returned in the order of the pages.
+func listAllPages(ctx context.Context, list pageListFunc) ([]interface{}, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	items, resp, err := list(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Links == nil || resp.Links.IsLastPage() {
+		return items, nil
+	}
+
+	if resp.Links.Pages == nil || resp.Links.Pages.Last == "" {
+		return listRemainingPages(ctx, list, items, resp)
+	}
+
+	lastPage, err := lastPageOf(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		pages    = make([][]interface{}, lastPage)
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		sem      = make(chan struct{}, listPagesConcurrency)
+	)
+	pages[0] = items
+
+	for page := 2; page <= lastPage; page++ {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(page int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			items, _, err := list(ctx, &godo.ListOptions{
+				Page:    page,
+				PerPage: opts.PerPage,
+			})
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+
+			pages[page-1] = items
+		}(page)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	var all []interface{}
+	for _, page := range pages {
+		all = append(all, page...)
+	}
+
+	return all, nil
+}
+
+// listRemainingPages fetches the pages following resp one after the other,
+// for the responses which do not link to the last page.
+func listRemainingPages(ctx context.Context, list pageListFunc, items []interface{}, resp *godo.Response) ([]interface{}, error) {
+	for resp.Links != nil && !resp.Links.IsLastPage() {
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+
+		var pageItems []interface{}
+		pageItems, resp, err = list(ctx, &godo.ListOptions{
+			Page:    page + 1,
+			PerPage: 200,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, pageItems...)
+	}
+
+	return items, nil
+}
+
+// lastPageOf returns the number of the last page of a paginated response.
+func lastPageOf(resp *godo.Response) (int, error) {
+	u, err := url.Parse(resp.Links.Pages.Last)
+	if err != nil {
+		return 0, fmt.Errorf("invalid link to the last page %q: %s", resp.Links.Pages.Last, err)
+	}
+
+	page, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid link to the last page %q: %s", resp.Links.Pages.Last, err)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,92 @@
+package digitalocean
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// fakePages lists pages of two items each, pages are numbered from 1.
+func fakePages(pages int, withLastLink bool, inFlight, maxInFlight *int32) pageListFunc {
+	return func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		if inFlight != nil {
+			n := atomic.AddInt32(inFlight, 1)
+			defer atomic.AddInt32(inFlight, -1)
+			for {
+				max := atomic.LoadInt32(maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(maxInFlight, max, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		links := &godo.Links{Pages: &godo.Pages{}}
+		if opts.Page > 1 {
+			links.Pages.Prev = fmt.Sprintf("https://api.digitalocean.com/v2/droplets?page=%d", opts.Page-1)
+		}
+		if opts.Page < pages {
+			links.Pages.Next = fmt.Sprintf("https://api.digitalocean.com/v2/droplets?page=%d", opts.Page+1)
+			if withLastLink {
+				links.Pages.Last = fmt.Sprintf("https://api.digitalocean.com/v2/droplets?page=%d", pages)
+			}
+		}
+
+		items := []interface{}{opts.Page*10 + 1, opts.Page*10 + 2}
+		return items, &godo.Response{Links: links}, nil
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	expected := []interface{}{11, 12, 21, 22, 31, 32, 41, 42, 51, 52, 61, 62, 71, 72}
+
+	var inFlight, maxInFlight int32
+	items, err := listAllPages(context.Background(), fakePages(7, true, &inFlight, &maxInFlight))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected %v, got %v", expected, items)
+	}
+	if maxInFlight > listPagesConcurrency {
+		t.Errorf("expected at most %d pages to be fetched at the same time, got %d", listPagesConcurrency, maxInFlight)
+	}
+
+	// Responses not linking to the last page are followed one by one.
+	items, err = listAllPages(context.Background(), fakePages(7, false, nil, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected %v, got %v", expected, items)
+	}
+}
+
+func TestListAllPages_SinglePage(t *testing.T) {
+	items, err := listAllPages(context.Background(), fakePages(1, true, nil, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(items, []interface{}{11, 12}) {
+		t.Errorf("expected the items of the first page, got %v", items)
+	}
+}
+
+func TestListAllPages_Error(t *testing.T) {
+	pages := fakePages(5, true, nil, nil)
+	_, err := listAllPages(context.Background(), func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		if opts.Page == 3 {
+			return nil, nil, errors.New("page 3 failed")
+		}
+		return pages(ctx, opts)
+	})
+	if err == nil || err.Error() != "page 3 failed" {
+		t.Errorf("expected the error of page 3, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+const (
+	partnerAttachmentsBasePath      = "/v2/partner_network_connect/attachments"
+	partnerAttachmentPath           = partnerAttachmentsBasePath + "/%s"
+	partnerAttachmentServiceKeyPath = partnerAttachmentsBasePath + "/%s/service_key"
+)
+
+// partnerAttachmentBGP is the BGP session configuration of a Partner Network
+// Connect attachment.
+type partnerAttachmentBGP struct {
+	LocalASN      uint32 `json:"local_asn,omitempty"`
+	LocalRouterIP string `json:"local_router_ip,omitempty"`
+	PeerASN       uint32 `json:"peer_asn,omitempty"`
+	PeerRouterIP  string `json:"peer_router_ip,omitempty"`
+	AuthKey       string `json:"auth_key,omitempty"`
+}
+
+// partnerAttachment is a connection between one or more VPCs and a network
+// provided by a DigitalOcean partner.
+type partnerAttachment struct {
+	ID                        string                `json:"id"`
+	Name                      string                `json:"name"`
+	State                     string                `json:"state"`
+	ConnectionBandwidthInMbps int                   `json:"connection_bandwidth_in_mbps"`
+	Region                    string                `json:"region"`
+	NaaSProvider              string                `json:"naas_provider"`
+	VPCIDs                    []string              `json:"vpc_ids"`
+	BGP                       *partnerAttachmentBGP `json:"bgp,omitempty"`
+	CreatedAt                 string                `json:"created_at"`
+}
+
+type partnerAttachmentCreateRequest struct {
+	Name                      string                `json:"name"`
+	ConnectionBandwidthInMbps int                   `json:"connection_bandwidth_in_mbps"`
+	Region                    string                `json:"region"`
+	NaaSProvider              string                `json:"naas_provider"`
+	VPCIDs                    []string              `json:"vpc_ids"`
+	BGP                       *partnerAttachmentBGP `json:"bgp,omitempty"`
+}
+
+type partnerAttachmentUpdateRequest struct {
+	Name   string   `json:"name,omitempty"`
+	VPCIDs []string `json:"vpc_ids,omitempty"`
+}
+
+type partnerAttachmentRoot struct {
+	PartnerAttachment *partnerAttachment `json:"partner_attachment"`
+}
+
+// partnerAttachmentServiceKey is handed to the partner to complete the
+// connection on their side.
+type partnerAttachmentServiceKey struct {
+	Value     string `json:"value"`
+	State     string `json:"state"`
+	CreatedAt string `json:"created_at"`
+}
+
+type partnerAttachmentServiceKeyRoot struct {
+	ServiceKey *partnerAttachmentServiceKey `json:"service_key"`
+}
+
+// createPartnerAttachment creates a Partner Network Connect attachment.
+func createPartnerAttachment(ctx context.Context, client *godo.Client, createRequest *partnerAttachmentCreateRequest) (*partnerAttachment, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodPost, partnerAttachmentsBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(partnerAttachmentRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.PartnerAttachment, resp, nil
+}
+
+// getPartnerAttachment retrieves a Partner Network Connect attachment.
+func getPartnerAttachment(ctx context.Context, client *godo.Client, id string) (*partnerAttachment, *godo.Response, error) {
+	path := fmt.Sprintf(partnerAttachmentPath, id)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(partnerAttachmentRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.PartnerAttachment, resp, nil
+}
+
+// updatePartnerAttachment updates the name and attached VPCs of a Partner
+// Network Connect attachment.
+func updatePartnerAttachment(ctx context.Context, client *godo.Client, id string, updateRequest *partnerAttachmentUpdateRequest) (*partnerAttachment, *godo.Response, error) {
+	path := fmt.Sprintf(partnerAttachmentPath, id)
+	req, err := client.NewRequest(ctx, http.MethodPatch, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(partnerAttachmentRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.PartnerAttachment, resp, nil
+}
+
+// deletePartnerAttachment deletes a Partner Network Connect attachment.
+func deletePartnerAttachment(ctx context.Context, client *godo.Client, id string) (*godo.Response, error) {
+	path := fmt.Sprintf(partnerAttachmentPath, id)
+	req, err := client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// getPartnerAttachmentServiceKey retrieves the service key of a Partner
+// Network Connect attachment. The key is generated asynchronously after the
+// attachment is created.
+func getPartnerAttachmentServiceKey(ctx context.Context, client *godo.Client, id string) (*partnerAttachmentServiceKey, *godo.Response, error) {
+	path := fmt.Sprintf(partnerAttachmentServiceKeyPath, id)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(partnerAttachmentServiceKeyRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.ServiceKey, resp, nil
+}
@@ -0,0 +1,114 @@
+package digitalocean
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// pollNotFoundChecks is the number of refreshes in a row not finding the
+// resource after which a poller gives up. Resources can take a moment to be
+// visible after being created.
+const pollNotFoundChecks = 20
+
+// poller waits for the resources being changed to reach a state. It refreshes
+// them right away, then waits interval before the next refresh and doubles
+// the wait after each one up to maxInterval.
+type poller struct {
+	interval    time.Duration
+	maxInterval time.Duration
+}
+
+// defaultPoller is used by the clients not configured by the provider, such
+// as the ones of the sweepers.
+var defaultPoller = &poller{
+	interval:    2 * time.Second,
+	maxInterval: 30 * time.Second,
+}
+
+func newPoller(interval, maxInterval time.Duration) *poller {
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+
+	return &poller{
+		interval:    interval,
+		maxInterval: maxInterval,
+	}
+}
+
+// waitForState refreshes a resource until it reaches one of the target
+// states. It fails if the resource reaches a state that is neither pending
+// nor a target, if refresh fails, or if the timeout passes or ctx is done
+// first. Like resource.StateChangeConf, the resource is considered not found
+// when refresh returns a nil result.
+func (p *poller) waitForState(ctx context.Context, timeout time.Duration, pending, target []string, refresh resource.StateRefreshFunc) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		lastState string
+		notFound  int
+	)
+
+	wait := p.interval
+	for {
+		result, state, err := refresh()
+		if err != nil {
+			return result, err
+		}
+
+		if result == nil {
+			notFound++
+			if notFound > pollNotFoundChecks {
+				return nil, &resource.NotFoundError{Retries: notFound}
+			}
+		} else {
+			notFound = 0
+			lastState = state
+
+			if stringInSlice(state, target) {
+				return result, nil
+			}
+			if !stringInSlice(state, pending) {
+				return result, &resource.UnexpectedStateError{
+					State:         state,
+					ExpectedState: target,
+				}
+			}
+		}
+
+		log.Printf("[TRACE] Waiting %s before refreshing the state (last state: %q)", wait, lastState)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if ctx.Err() == context.DeadlineExceeded {
+				return result, &resource.TimeoutError{
+					LastState:     lastState,
+					Timeout:       timeout,
+					ExpectedState: target,
+				}
+			}
+			return result, ctx.Err()
+		case <-timer.C:
+		}
+
+		wait *= 2
+		if wait > p.maxInterval {
+			wait = p.maxInterval
+		}
+	}
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
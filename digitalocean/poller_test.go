@@ -0,0 +1,114 @@
+package digitalocean
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestPoller_WaitForState(t *testing.T) {
+	p := newPoller(time.Millisecond, 4*time.Millisecond)
+
+	var refreshes int
+	result, err := p.waitForState(context.Background(), time.Minute, []string{"new"}, []string{"active"}, func() (interface{}, string, error) {
+		refreshes++
+		if refreshes < 5 {
+			return "droplet", "new", nil
+		}
+		return "droplet", "active", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result != "droplet" {
+		t.Errorf("expected the result of the last refresh, got %v", result)
+	}
+	if refreshes != 5 {
+		t.Errorf("expected 5 refreshes, got %d", refreshes)
+	}
+}
+
+func TestPoller_WaitForState_Backoff(t *testing.T) {
+	p := newPoller(10*time.Millisecond, 40*time.Millisecond)
+
+	var times []time.Time
+	_, err := p.waitForState(context.Background(), time.Minute, []string{"new"}, []string{"active"}, func() (interface{}, string, error) {
+		times = append(times, time.Now())
+		if len(times) < 5 {
+			return "droplet", "new", nil
+		}
+		return "droplet", "active", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The waits are 10ms, 20ms, 40ms and 40ms.
+	for i, expected := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond} {
+		if wait := times[i+1].Sub(times[i]); wait < expected {
+			t.Errorf("expected to wait at least %s before refresh %d, waited %s", expected, i+2, wait)
+		}
+	}
+}
+
+func TestPoller_WaitForState_Errors(t *testing.T) {
+	p := newPoller(time.Millisecond, time.Millisecond)
+
+	_, err := p.waitForState(context.Background(), time.Minute, []string{"new"}, []string{"active"}, func() (interface{}, string, error) {
+		return "droplet", "errored", nil
+	})
+	if _, ok := err.(*resource.UnexpectedStateError); !ok {
+		t.Errorf("expected an unexpected state error, got %v", err)
+	}
+
+	refreshErr := errors.New("refresh failed")
+	_, err = p.waitForState(context.Background(), time.Minute, []string{"new"}, []string{"active"}, func() (interface{}, string, error) {
+		return nil, "", refreshErr
+	})
+	if err != refreshErr {
+		t.Errorf("expected the refresh error, got %v", err)
+	}
+
+	_, err = p.waitForState(context.Background(), time.Minute, []string{"new"}, []string{"active"}, func() (interface{}, string, error) {
+		return nil, "", nil
+	})
+	if _, ok := err.(*resource.NotFoundError); !ok {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestPoller_WaitForState_Timeout(t *testing.T) {
+	p := newPoller(time.Millisecond, 5*time.Millisecond)
+
+	_, err := p.waitForState(context.Background(), 50*time.Millisecond, []string{"new"}, []string{"active"}, func() (interface{}, string, error) {
+		return "droplet", "new", nil
+	})
+	timeoutErr, ok := err.(*resource.TimeoutError)
+	if !ok {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+	if timeoutErr.LastState != "new" {
+		t.Errorf("expected the last state to be reported, got %q", timeoutErr.LastState)
+	}
+}
+
+func TestPoller_WaitForState_ContextCancelled(t *testing.T) {
+	p := newPoller(time.Hour, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := p.waitForState(ctx, time.Hour, []string{"new"}, []string{"active"}, func() (interface{}, string, error) {
+		return "droplet", "new", nil
+	})
+	if err != context.Canceled {
+		t.Errorf("expected the context to be cancelled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected to stop waiting once the context is cancelled, waited %s", elapsed)
+	}
+}
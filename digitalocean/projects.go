@@ -3,12 +3,48 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
+	"sync"
 
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// projectEnvironments are the environments a project can be in.
+var projectEnvironments = []string{"Development", "Staging", "Production"}
+
+// projectPurposes are the purposes offered by the API. Any other purpose is
+// accepted and stored as "Other: <purpose>".
+var projectPurposes = []string{
+	"Just trying out DigitalOcean",
+	"Class project / Educational purposes",
+	"Website or blog",
+	"Web Application",
+	"Service or API",
+	"Mobile Application",
+	"Machine learning / AI / Data processing",
+	"IoT",
+	"Operational / Developer tooling",
+}
+
+// validateProjectPurpose checks the length of a purpose and rejects the ones
+// which only differ from one of projectPurposes by case, as the API would
+// store them as a custom purpose rather than the intended one.
+func validateProjectPurpose(v interface{}, k string) ([]string, []error) {
+	warnings, errors := validation.StringLenBetween(0, 255)(v, k)
+
+	purpose := v.(string)
+	for _, known := range projectPurposes {
+		if purpose != known && strings.EqualFold(purpose, known) {
+			errors = append(errors, fmt.Errorf("expected %s to be %q, got %q", k, known, purpose))
+		}
+	}
+
+	return warnings, errors
+}
+
 func projectSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"id": {
@@ -119,3 +155,298 @@ func flattenDigitalOceanProject(rawProject interface{}, meta interface{}, extra
 
 	return flattenedProject, nil
 }
+
+// projectIDSchema is the schema for the project_id argument used to assign a
+// resource to a project. The API only reports the resources of a project, so
+// the assignment is checked by readProjectAssignment rather than read back.
+func projectIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+}
+
+// assignToProject assigns the resource identified by urn to the project set in
+// project_id. If project_id has been removed, the resource is returned to the
+// default project.
+func assignToProject(client *godo.Client, d *schema.ResourceData, urn string) error {
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		if !d.HasChange("project_id") {
+			return nil
+		}
+
+		defaultProject, _, err := client.Projects.GetDefault(context.Background())
+		if err != nil {
+			return fmt.Errorf("Error locating default project: %s", err)
+		}
+		projectID = defaultProject.ID
+	}
+
+	_, _, err := client.Projects.AssignResources(context.Background(), projectID, urn)
+	if err != nil {
+		return fmt.Errorf("Error assigning %s to project %s: %s", urn, projectID, err)
+	}
+
+	return nil
+}
+
+// projectResourcesCache holds the URNs of the resources assigned to each
+// project, so that refreshing or importing many resources lists each of their
+// projects once per run rather than once per resource.
+type projectResourcesCache struct {
+	mu       sync.Mutex
+	projects map[string]*projectResources
+}
+
+type projectResources struct {
+	once  sync.Once
+	urns  map[string]bool
+	found bool
+	err   error
+}
+
+// get returns the URNs of the resources assigned to the project, and whether
+// the project exists, listing them on first use.
+func (c *projectResourcesCache) get(client *godo.Client, projectID string) (map[string]bool, bool, error) {
+	c.mu.Lock()
+	if c.projects == nil {
+		c.projects = make(map[string]*projectResources)
+	}
+	p, ok := c.projects[projectID]
+	if !ok {
+		p = &projectResources{}
+		c.projects[projectID] = p
+	}
+	c.mu.Unlock()
+
+	p.once.Do(func() {
+		_, resp, err := client.Projects.Get(context.Background(), projectID)
+		if err != nil {
+			if resp == nil || resp.StatusCode != 404 {
+				p.err = fmt.Errorf("Error retrieving project %s: %s", projectID, err)
+			}
+			return
+		}
+
+		resources, err := listProjectResources(client, projectID)
+		if err != nil {
+			p.err = fmt.Errorf("Error retrieving resources of project %s: %s", projectID, err)
+			return
+		}
+
+		p.found = true
+		p.urns = make(map[string]bool, len(resources))
+		for _, r := range resources {
+			p.urns[r.URN] = true
+		}
+	})
+
+	return p.urns, p.found, p.err
+}
+
+// forget drops the resources of the project, so that they are listed again
+// on next use.
+func (c *projectResourcesCache) forget(projectID string) {
+	c.mu.Lock()
+	delete(c.projects, projectID)
+	c.mu.Unlock()
+}
+
+// readProjectAssignment checks that the resource identified by urn is still
+// assigned to the project set in project_id. If it was moved to another
+// project, or the project was deleted, project_id is cleared so that the
+// assignment is planned again.
+func readProjectAssignment(meta interface{}, d *schema.ResourceData, urn string) error {
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		return nil
+	}
+
+	cache := meta.(*CombinedConfig).projectResources()
+	client := meta.(*CombinedConfig).godoClient()
+
+	urns, found, err := cache.get(client, projectID)
+	if err == nil && found && !urns[urn] {
+		// The resource may have been assigned after the project was
+		// listed, check it again before planning the assignment.
+		cache.forget(projectID)
+		urns, found, err = cache.get(client, projectID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		log.Printf("[WARN] Project %s of %s not found", projectID, urn)
+		d.Set("project_id", "")
+		return nil
+	}
+
+	if !urns[urn] {
+		log.Printf("[WARN] %s is no longer assigned to project %s", urn, projectID)
+		d.Set("project_id", "")
+	}
+
+	return nil
+}
+
+// importProjectAssignment sets project_id of an imported resource to the
+// project it is assigned to. Resources of the default project are left
+// without a project_id, as that is where they are assigned when it is not set.
+func importProjectAssignment(meta interface{}, d *schema.ResourceData, urn string) error {
+	projects, err := getDigitalOceanProjects(meta, nil)
+	if err != nil {
+		return err
+	}
+
+	cache := meta.(*CombinedConfig).projectResources()
+	client := meta.(*CombinedConfig).godoClient()
+
+	for _, p := range projects {
+		project := p.(godo.Project)
+		if project.IsDefault {
+			continue
+		}
+
+		urns, _, err := cache.get(client, project.ID)
+		if err != nil {
+			return err
+		}
+
+		if urns[urn] {
+			d.Set("project_id", project.ID)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// importStateWithProject returns an importer which imports the resource by
+// its ID, like schema.ImportStatePassthroughContext, and sets its project_id.
+// urn builds the URN of the resource from its ID.
+func importStateWithProject(urn func(id string) string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if err := importProjectAssignment(meta, d, urn(d.Id())); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// listProjectResources retrieves all of the resources assigned to a project.
+func listProjectResources(client *godo.Client, projectID string) ([]godo.ProjectResource, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	resourceList := []godo.ProjectResource{}
+	for {
+		resources, resp, err := client.Projects.ListResources(context.Background(), projectID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading project resources: %s", err)
+		}
+
+		resourceList = append(resourceList, resources...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error loading project resources: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return resourceList, nil
+}
+
+// parseURN splits the URN of a resource, e.g. do:droplet:4126873, into the
+// type and the ID of the resource.
+func parseURN(urn string) (string, string, error) {
+	parts := strings.SplitN(urn, ":", 3)
+	if len(parts) != 3 || parts[0] != "do" || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("Invalid URN %q, expected do:<type>:<id>", urn)
+	}
+
+	return parts[1], parts[2], nil
+}
+
+func projectResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name of the resource",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "the type of the resource parsed from its URN, e.g. droplet or volume",
+		},
+		"resource_id": {
+			Type:        schema.TypeString,
+			Description: "the ID of the resource parsed from its URN",
+		},
+		"assigned_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the resource was assigned to the project, (ISO8601)",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "the status of the resource, e.g. ok or not_found",
+		},
+	}
+}
+
+func getDigitalOceanProjectResources(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	projectID, _ := extra["project_id"].(string)
+	if projectID == "" {
+		defaultProject, _, err := client.Projects.GetDefault(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("Error locating default project: %s", err)
+		}
+		projectID = defaultProject.ID
+	}
+
+	resources, err := listProjectResources(client, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	allResources := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		allResources = append(allResources, resource)
+	}
+
+	return allResources, nil
+}
+
+func flattenDigitalOceanProjectResource(rawResource interface{}, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	resource, ok := rawResource.(godo.ProjectResource)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.ProjectResource")
+	}
+
+	resourceType, resourceID, err := parseURN(resource.URN)
+	if err != nil {
+		return nil, err
+	}
+
+	flattenedResource := map[string]interface{}{
+		"urn":         resource.URN,
+		"type":        resourceType,
+		"resource_id": resourceID,
+		"assigned_at": resource.AssignedAt,
+		"status":      resource.Status,
+	}
+
+	return flattenedResource, nil
+}
@@ -0,0 +1,159 @@
+package digitalocean
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestReadProjectAssignment(t *testing.T) {
+	const projectID = "4e1bfbc3-dc3e-41f2-a18f-1b4d7ba71679"
+
+	cases := []struct {
+		name      string
+		resources string
+		status    int
+		expected  string
+	}{
+		{
+			name:      "assigned",
+			resources: `[{"urn":"do:droplet:1"},{"urn":"do:droplet:2"}]`,
+			status:    http.StatusOK,
+			expected:  projectID,
+		},
+		{
+			name:      "moved",
+			resources: `[{"urn":"do:droplet:2"}]`,
+			status:    http.StatusOK,
+			expected:  "",
+		},
+		{
+			name:     "project deleted",
+			status:   http.StatusNotFound,
+			expected: "",
+		},
+	}
+
+	for _, c := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if c.status != http.StatusOK {
+				w.WriteHeader(c.status)
+				fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+				return
+			}
+
+			switch r.URL.Path {
+			case "/v2/projects/" + projectID:
+				fmt.Fprintf(w, `{"project":{"id":%q}}`, projectID)
+			case "/v2/projects/" + projectID + "/resources":
+				fmt.Fprintf(w, `{"resources":%s,"links":{},"meta":{"total":1}}`, c.resources)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		client := godo.NewClient(nil)
+		client.BaseURL, _ = url.Parse(server.URL)
+
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"project_id": projectIDSchema()}, map[string]interface{}{
+			"project_id": projectID,
+		})
+
+		err := readProjectAssignment(&CombinedConfig{client: client}, d, "do:droplet:1")
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		if actual := d.Get("project_id").(string); actual != c.expected {
+			t.Errorf("%s: expected project_id %q, got %q", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestReadProjectAssignment_ListsProjectOnce(t *testing.T) {
+	const projectID = "4e1bfbc3-dc3e-41f2-a18f-1b4d7ba71679"
+
+	listings := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/projects/" + projectID:
+			fmt.Fprintf(w, `{"project":{"id":%q}}`, projectID)
+		case "/v2/projects/" + projectID + "/resources":
+			listings++
+			fmt.Fprint(w, `{"resources":[{"urn":"do:droplet:1"},{"urn":"do:droplet:2"}],"links":{},"meta":{"total":2}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	meta := &CombinedConfig{client: client, projects: &projectResourcesCache{}}
+
+	for _, urn := range []string{"do:droplet:1", "do:droplet:2"} {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"project_id": projectIDSchema()}, map[string]interface{}{
+			"project_id": projectID,
+		})
+
+		if err := readProjectAssignment(meta, d, urn); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if actual := d.Get("project_id").(string); actual != projectID {
+			t.Errorf("%s: expected project_id %q, got %q", urn, projectID, actual)
+		}
+	}
+
+	if listings != 1 {
+		t.Errorf("expected the resources of the project to be listed once, got %d", listings)
+	}
+}
+
+func TestImportProjectAssignment(t *testing.T) {
+	const defaultID = "0e4c3c5a-5d0c-4e8f-9a29-a8c5d5f1f1a6"
+	const projectID = "4e1bfbc3-dc3e-41f2-a18f-1b4d7ba71679"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/projects":
+			fmt.Fprintf(w, `{"projects":[{"id":%q,"is_default":true},{"id":%q}],"links":{},"meta":{"total":2}}`, defaultID, projectID)
+		case "/v2/projects/" + defaultID, "/v2/projects/" + projectID:
+			fmt.Fprint(w, `{"project":{}}`)
+		case "/v2/projects/" + defaultID + "/resources":
+			fmt.Fprint(w, `{"resources":[{"urn":"do:droplet:2"}],"links":{},"meta":{"total":1}}`)
+		case "/v2/projects/" + projectID + "/resources":
+			fmt.Fprint(w, `{"resources":[{"urn":"do:droplet:1"}],"links":{},"meta":{"total":1}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	meta := &CombinedConfig{client: client, projects: &projectResourcesCache{}}
+
+	cases := map[string]string{
+		"do:droplet:1": projectID,
+		"do:droplet:2": "",
+	}
+
+	for urn, expected := range cases {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"project_id": projectIDSchema()}, map[string]interface{}{})
+
+		if err := importProjectAssignment(meta, d, urn); err != nil {
+			t.Fatalf("%s: unexpected error: %s", urn, err)
+		}
+		if actual := d.Get("project_id").(string); actual != expected {
+			t.Errorf("%s: expected project_id %q, got %q", urn, expected, actual)
+		}
+	}
+}
@@ -1,7 +1,14 @@
 package digitalocean
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/digitalocean/terraform-provider-digitalocean/internal/mutexkv"
 )
@@ -20,13 +27,34 @@ func Provider() *schema.Provider {
 					"DIGITALOCEAN_TOKEN",
 					"DIGITALOCEAN_ACCESS_TOKEN",
 				}, nil),
+				Sensitive:   true,
 				Description: "The token key for API operations.",
 			},
-			"api_endpoint": {
+			"token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_TOKEN_FILE", ""),
+				Description: "The path to a file containing the token key, used if token is not set.",
+			},
+			"token_command": {
 				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_API_URL", "https://api.digitalocean.com"),
-				Description: "The URL to use for the DigitalOcean API.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_TOKEN_COMMAND", ""),
+				Description: "A command printing the token key, used if neither token nor token_file are set.",
+			},
+			"token_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_TOKEN_CACHE_TTL", 300),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How long in seconds to reuse the token read from token_file or printed by token_command.",
+			},
+			"api_endpoint": {
+				Type:         schema.TypeString,
+				Required:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_API_URL", "https://api.digitalocean.com"),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "The URL to use for the DigitalOcean API.",
 			},
 			"spaces_endpoint": {
 				Type:        schema.TypeString,
@@ -34,6 +62,13 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("SPACES_ENDPOINT_URL", "https://{{.Region}}.digitaloceanspaces.com"),
 				Description: "The URL to use for the DigitalOcean Spaces API.",
 			},
+			"spaces_default_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SPACES_DEFAULT_REGION", ""),
+				ValidateFunc: validation.StringInSlice(append([]string{""}, SpacesRegions...), true),
+				Description:  "The region of the Spaces resources and data sources which do not set one.",
+			},
 			"spaces_access_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -44,77 +79,187 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SPACES_SECRET_ACCESS_KEY", nil),
+				Sensitive:   true,
 				Description: "The secret access key for Spaces API operations.",
 			},
+			"requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_REQUESTS_PER_SECOND", 0.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The maximum number of API requests per second, unlimited if 0.",
+			},
+			"http_retry_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_HTTP_RETRY_MAX", 4),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of retries of a request rejected by the rate limit of the API.",
+			},
+			"http_retry_wait_min": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_HTTP_RETRY_WAIT_MIN", 1.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The minimum time in seconds to wait before retrying a request.",
+			},
+			"http_retry_wait_max": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_HTTP_RETRY_WAIT_MAX", 30.0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The maximum time in seconds to wait before retrying a request.",
+			},
+			"http_retry_backoff": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_HTTP_RETRY_BACKOFF", retryBackoffExponential),
+				ValidateFunc: validation.StringInSlice([]string{
+					retryBackoffExponential,
+					retryBackoffConstant,
+				}, false),
+				Description: "How the time to wait grows between retries, exponential or constant.",
+			},
+			"poll_interval": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_POLL_INTERVAL", 2.0),
+				ValidateFunc: validation.FloatAtLeast(0.1),
+				Description:  "The time in seconds to wait before checking again whether an action or a resource is ready, doubled after each check.",
+			},
+			"poll_interval_max": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_POLL_INTERVAL_MAX", 30.0),
+				ValidateFunc: validation.FloatAtLeast(0.1),
+				Description:  "The maximum time in seconds to wait between two checks of an action or a resource.",
+			},
+			"user_agent_suffix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DIGITALOCEAN_USER_AGENT_SUFFIX", ""),
+				ValidateFunc: validation.StringDoesNotContainAny("\r\n"),
+				Description:  "A string appended to the User-Agent header of the requests to the API and Spaces.",
+			},
+			"http_debug_log": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_HTTP_DEBUG_LOG", false),
+				Description: "Whether to log the requests to the API and Spaces and their responses, with their credentials redacted.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_INSECURE_SKIP_VERIFY", false),
+				Description: "Whether to skip the verification of the TLS certificates of the API and Spaces endpoints.",
+			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DIGITALOCEAN_CA_CERT_FILE", ""),
+				Description: "The path to a PEM encoded CA certificate bundle to trust in addition to the system ones.",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"digitalocean_account":               dataSourceDigitalOceanAccount(),
-			"digitalocean_app":                   dataSourceDigitalOceanApp(),
-			"digitalocean_certificate":           dataSourceDigitalOceanCertificate(),
-			"digitalocean_container_registry":    dataSourceDigitalOceanContainerRegistry(),
-			"digitalocean_database_cluster":      dataSourceDigitalOceanDatabaseCluster(),
-			"digitalocean_domain":                dataSourceDigitalOceanDomain(),
-			"digitalocean_domains":               dataSourceDigitalOceanDomains(),
-			"digitalocean_droplet":               dataSourceDigitalOceanDroplet(),
-			"digitalocean_droplets":              dataSourceDigitalOceanDroplets(),
-			"digitalocean_droplet_snapshot":      dataSourceDigitalOceanDropletSnapshot(),
-			"digitalocean_firewall":              dataSourceDigitalOceanFirewall(),
-			"digitalocean_floating_ip":           dataSourceDigitalOceanFloatingIp(),
-			"digitalocean_image":                 dataSourceDigitalOceanImage(),
-			"digitalocean_images":                dataSourceDigitalOceanImages(),
-			"digitalocean_kubernetes_cluster":    dataSourceDigitalOceanKubernetesCluster(),
-			"digitalocean_kubernetes_versions":   dataSourceDigitalOceanKubernetesVersions(),
-			"digitalocean_loadbalancer":          dataSourceDigitalOceanLoadbalancer(),
-			"digitalocean_project":               dataSourceDigitalOceanProject(),
-			"digitalocean_projects":              dataSourceDigitalOceanProjects(),
-			"digitalocean_record":                dataSourceDigitalOceanRecord(),
-			"digitalocean_records":               dataSourceDigitalOceanRecords(),
-			"digitalocean_region":                dataSourceDigitalOceanRegion(),
-			"digitalocean_regions":               dataSourceDigitalOceanRegions(),
-			"digitalocean_sizes":                 dataSourceDigitalOceanSizes(),
-			"digitalocean_spaces_bucket":         dataSourceDigitalOceanSpacesBucket(),
-			"digitalocean_spaces_buckets":        dataSourceDigitalOceanSpacesBuckets(),
-			"digitalocean_spaces_bucket_object":  dataSourceDigitalOceanSpacesBucketObject(),
-			"digitalocean_spaces_bucket_objects": dataSourceDigitalOceanSpacesBucketObjects(),
-			"digitalocean_ssh_key":               dataSourceDigitalOceanSSHKey(),
-			"digitalocean_ssh_keys":              dataSourceDigitalOceanSSHKeys(),
-			"digitalocean_tag":                   dataSourceDigitalOceanTag(),
-			"digitalocean_tags":                  dataSourceDigitalOceanTags(),
-			"digitalocean_volume_snapshot":       dataSourceDigitalOceanVolumeSnapshot(),
-			"digitalocean_volume":                dataSourceDigitalOceanVolume(),
-			"digitalocean_vpc":                   dataSourceDigitalOceanVPC(),
-			"digitalocean_database_replica":      dataSourceDigitalOceanDatabaseReplica(),
+			"digitalocean_account":                            dataSourceDigitalOceanAccount(),
+			"digitalocean_app":                                dataSourceDigitalOceanApp(),
+			"digitalocean_balance":                            dataSourceDigitalOceanBalance(),
+			"digitalocean_billing_history":                    dataSourceDigitalOceanBillingHistory(),
+			"digitalocean_certificate":                        dataSourceDigitalOceanCertificate(),
+			"digitalocean_certificates":                       dataSourceDigitalOceanCertificates(),
+			"digitalocean_container_registry":                 dataSourceDigitalOceanContainerRegistry(),
+			"digitalocean_container_registry_repositories":    dataSourceDigitalOceanContainerRegistryRepositories(),
+			"digitalocean_container_registry_repository_tags": dataSourceDigitalOceanContainerRegistryRepositoryTags(),
+			"digitalocean_database_ca":                        dataSourceDigitalOceanDatabaseCA(),
+			"digitalocean_database_cluster":                   dataSourceDigitalOceanDatabaseCluster(),
+			"digitalocean_database_events":                    dataSourceDigitalOceanDatabaseEvents(),
+			"digitalocean_domain":                             dataSourceDigitalOceanDomain(),
+			"digitalocean_domains":                            dataSourceDigitalOceanDomains(),
+			"digitalocean_droplet":                            dataSourceDigitalOceanDroplet(),
+			"digitalocean_droplet_bandwidth":                  dataSourceDigitalOceanDropletBandwidth(),
+			"digitalocean_droplets":                           dataSourceDigitalOceanDroplets(),
+			"digitalocean_droplet_snapshot":                   dataSourceDigitalOceanDropletSnapshot(),
+			"digitalocean_firewall":                           dataSourceDigitalOceanFirewall(),
+			"digitalocean_firewalls":                          dataSourceDigitalOceanFirewalls(),
+			"digitalocean_floating_ip":                        dataSourceDigitalOceanFloatingIp(),
+			"digitalocean_image":                              dataSourceDigitalOceanImage(),
+			"digitalocean_images":                             dataSourceDigitalOceanImages(),
+			"digitalocean_invoice":                            dataSourceDigitalOceanInvoice(),
+			"digitalocean_kubernetes_cluster":                 dataSourceDigitalOceanKubernetesCluster(),
+			"digitalocean_kubernetes_versions":                dataSourceDigitalOceanKubernetesVersions(),
+			"digitalocean_loadbalancer":                       dataSourceDigitalOceanLoadbalancer(),
+			"digitalocean_monitor_alerts":                     dataSourceDigitalOceanMonitorAlerts(),
+			"digitalocean_project":                            dataSourceDigitalOceanProject(),
+			"digitalocean_project_resources":                  dataSourceDigitalOceanProjectResources(),
+			"digitalocean_projects":                           dataSourceDigitalOceanProjects(),
+			"digitalocean_rate_limit":                         dataSourceDigitalOceanRateLimit(),
+			"digitalocean_record":                             dataSourceDigitalOceanRecord(),
+			"digitalocean_records":                            dataSourceDigitalOceanRecords(),
+			"digitalocean_region":                             dataSourceDigitalOceanRegion(),
+			"digitalocean_regions":                            dataSourceDigitalOceanRegions(),
+			"digitalocean_sizes":                              dataSourceDigitalOceanSizes(),
+			"digitalocean_spaces_bucket":                      dataSourceDigitalOceanSpacesBucket(),
+			"digitalocean_spaces_buckets":                     dataSourceDigitalOceanSpacesBuckets(),
+			"digitalocean_spaces_bucket_object":               dataSourceDigitalOceanSpacesBucketObject(),
+			"digitalocean_spaces_bucket_objects":              dataSourceDigitalOceanSpacesBucketObjects(),
+			"digitalocean_ssh_key":                            dataSourceDigitalOceanSSHKey(),
+			"digitalocean_ssh_keys":                           dataSourceDigitalOceanSSHKeys(),
+			"digitalocean_tag":                                dataSourceDigitalOceanTag(),
+			"digitalocean_tags":                               dataSourceDigitalOceanTags(),
+			"digitalocean_volume_snapshot":                    dataSourceDigitalOceanVolumeSnapshot(),
+			"digitalocean_volume":                             dataSourceDigitalOceanVolume(),
+			"digitalocean_vpc":                                dataSourceDigitalOceanVPC(),
+			"digitalocean_vpc_members":                        dataSourceDigitalOceanVPCMembers(),
+			"digitalocean_database_replica":                   dataSourceDigitalOceanDatabaseReplica(),
+			"digitalocean_database_replicas":                  dataSourceDigitalOceanDatabaseReplicas(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"digitalocean_app":                                   resourceDigitalOceanApp(),
+			"digitalocean_byoip_address_assignment":              resourceDigitalOceanBYOIPAddressAssignment(),
+			"digitalocean_byoip_prefix":                          resourceDigitalOceanBYOIPPrefix(),
 			"digitalocean_certificate":                           resourceDigitalOceanCertificate(),
 			"digitalocean_container_registry":                    resourceDigitalOceanContainerRegistry(),
+			"digitalocean_container_registry_repository_tag":     resourceDigitalOceanContainerRegistryRepositoryTag(),
+			"digitalocean_container_registry_retention_policy":   resourceDigitalOceanContainerRegistryRetentionPolicy(),
 			"digitalocean_container_registry_docker_credentials": resourceDigitalOceanContainerRegistryDockerCredentials(),
 			"digitalocean_cdn":                                   resourceDigitalOceanCDN(),
 			"digitalocean_database_cluster":                      resourceDigitalOceanDatabaseCluster(),
 			"digitalocean_database_connection_pool":              resourceDigitalOceanDatabaseConnectionPool(),
 			"digitalocean_database_db":                           resourceDigitalOceanDatabaseDB(),
 			"digitalocean_database_firewall":                     resourceDigitalOceanDatabaseFirewall(),
+			"digitalocean_database_firewall_rule":                resourceDigitalOceanDatabaseFirewallRule(),
 			"digitalocean_database_replica":                      resourceDigitalOceanDatabaseReplica(),
 			"digitalocean_database_user":                         resourceDigitalOceanDatabaseUser(),
 			"digitalocean_domain":                                resourceDigitalOceanDomain(),
+			"digitalocean_domain_zone_import":                    resourceDigitalOceanDomainZoneImport(),
 			"digitalocean_droplet":                               resourceDigitalOceanDroplet(),
 			"digitalocean_droplet_snapshot":                      resourceDigitalOceanDropletSnapshot(),
 			"digitalocean_firewall":                              resourceDigitalOceanFirewall(),
+			"digitalocean_firewall_rule":                         resourceDigitalOceanFirewallRule(),
 			"digitalocean_floating_ip":                           resourceDigitalOceanFloatingIp(),
 			"digitalocean_floating_ip_assignment":                resourceDigitalOceanFloatingIpAssignment(),
+			"digitalocean_global_load_balancer":                  resourceDigitalOceanGlobalLoadBalancer(),
 			"digitalocean_kubernetes_cluster":                    resourceDigitalOceanKubernetesCluster(),
 			"digitalocean_kubernetes_node_pool":                  resourceDigitalOceanKubernetesNodePool(),
 			"digitalocean_loadbalancer":                          resourceDigitalOceanLoadbalancer(),
 			"digitalocean_monitor_alert":                         resourceDigitalOceanMonitorAlert(),
+			"digitalocean_partner_attachment":                    resourceDigitalOceanPartnerAttachment(),
 			"digitalocean_project":                               resourceDigitalOceanProject(),
 			"digitalocean_project_resources":                     resourceDigitalOceanProjectResources(),
 			"digitalocean_record":                                resourceDigitalOceanRecord(),
+			"digitalocean_record_set":                            resourceDigitalOceanRecordSet(),
+			"digitalocean_reserved_ipv6":                         resourceDigitalOceanReservedIPv6(),
+			"digitalocean_reserved_ipv6_assignment":              resourceDigitalOceanReservedIPv6Assignment(),
+			"digitalocean_snapshot_retention":                    resourceDigitalOceanSnapshotRetention(),
+			"digitalocean_snapshot_transfer":                     resourceDigitalOceanSnapshotTransfer(),
 			"digitalocean_spaces_bucket":                         resourceDigitalOceanBucket(),
 			"digitalocean_spaces_bucket_object":                  resourceDigitalOceanSpacesBucketObject(),
 			"digitalocean_ssh_key":                               resourceDigitalOceanSSHKey(),
 			"digitalocean_tag":                                   resourceDigitalOceanTag(),
+			"digitalocean_tag_assignment":                        resourceDigitalOceanTagAssignment(),
+			"digitalocean_uptime_alert":                          resourceDigitalOceanUptimeAlert(),
+			"digitalocean_uptime_check":                          resourceDigitalOceanUptimeCheck(),
 			"digitalocean_volume":                                resourceDigitalOceanVolume(),
 			"digitalocean_volume_attachment":                     resourceDigitalOceanVolumeAttachment(),
 			"digitalocean_volume_snapshot":                       resourceDigitalOceanVolumeSnapshot(),
@@ -123,31 +268,85 @@ func Provider() *schema.Provider {
 		},
 	}
 
-	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+	for _, r := range p.DataSourcesMap {
+		withAPIErrorDiagnostics(r)
+	}
+	for _, r := range p.ResourcesMap {
+		withAPIErrorDiagnostics(r)
+	}
+
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
 			// Terraform 0.12 introduced this field to the protocol
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(d, terraformVersion)
+		config, diags := providerConfig(d, terraformVersion)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		client, err := config.Client()
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		return client, nil
 	}
 
 	return p
 }
 
-func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
-	config := Config{
+// providerConfig returns the configuration of the clients set in the
+// provider block.
+func providerConfig(d *schema.ResourceData, terraformVersion string) (*Config, diag.Diagnostics) {
+	config := &Config{
 		Token:            d.Get("token").(string),
+		TokenFile:        d.Get("token_file").(string),
+		TokenCommand:     d.Get("token_command").(string),
+		TokenCacheTTL:    time.Duration(d.Get("token_cache_ttl").(int)) * time.Second,
 		APIEndpoint:      d.Get("api_endpoint").(string),
 		AccessID:         d.Get("spaces_access_id").(string),
+		SpacesRegion:     d.Get("spaces_default_region").(string),
 		SecretKey:        d.Get("spaces_secret_key").(string),
 		TerraformVersion: terraformVersion,
+		UserAgentSuffix:  d.Get("user_agent_suffix").(string),
+
+		RequestsPerSecond: d.Get("requests_per_second").(float64),
+		HTTPRetryMax:      d.Get("http_retry_max").(int),
+		HTTPRetryWaitMin:  d.Get("http_retry_wait_min").(float64),
+		HTTPRetryWaitMax:  d.Get("http_retry_wait_max").(float64),
+		HTTPRetryBackoff:  d.Get("http_retry_backoff").(string),
+		PollInterval:      d.Get("poll_interval").(float64),
+		PollIntervalMax:   d.Get("poll_interval_max").(float64),
+
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+		CACertFile:         d.Get("ca_cert_file").(string),
+		HTTPDebugLog:       d.Get("http_debug_log").(bool),
+	}
+
+	if config.HTTPRetryWaitMin > config.HTTPRetryWaitMax {
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid retry wait",
+			Detail:        fmt.Sprintf("http_retry_wait_min (%g) must not be greater than http_retry_wait_max (%g)", config.HTTPRetryWaitMin, config.HTTPRetryWaitMax),
+			AttributePath: cty.GetAttrPath("http_retry_wait_min"),
+		}}
+	}
+
+	if config.PollInterval > config.PollIntervalMax {
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid poll interval",
+			Detail:        fmt.Sprintf("poll_interval (%g) must not be greater than poll_interval_max (%g)", config.PollInterval, config.PollIntervalMax),
+			AttributePath: cty.GetAttrPath("poll_interval"),
+		}}
 	}
 
 	if endpoint, ok := d.GetOk("spaces_endpoint"); ok {
 		config.SpacesAPIEndpoint = endpoint.(string)
 	}
 
-	return config.Client()
+	return config, nil
 }
@@ -2,13 +2,21 @@ package digitalocean
 
 import (
 	"context"
+	"encoding/pem"
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -22,6 +30,9 @@ var testAccProviderFactories map[string]func() (*schema.Provider, error)
 
 func init() {
 	testAccProvider = Provider()
+	if vcrMode() != "" {
+		testAccProvider.ConfigureContextFunc = vcrConfigure(testAccProvider)
+	}
 	testAccProviders = map[string]*schema.Provider{
 		"digitalocean": testAccProvider,
 	}
@@ -42,7 +53,48 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+// vcrConfigure returns a ConfigureContextFunc configuring the clients of p to
+// record or replay their requests.
+func vcrConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+	var (
+		once        sync.Once
+		cassette    *vcrCassette
+		cassetteErr error
+	)
+
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		once.Do(func() {
+			cassette, cassetteErr = newVCRCassette(vcrMode(), vcrCassettePath())
+		})
+		if cassetteErr != nil {
+			return nil, diag.FromErr(cassetteErr)
+		}
+
+		config, diags := providerConfig(d, p.TerraformVersion)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.wrapTransport = cassette.transport
+
+		client, err := config.Client()
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		return client, nil
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
+	// The replayed requests are not authenticated.
+	if vcrMode() == vcrModeReplay {
+		for _, name := range []string{"DIGITALOCEAN_TOKEN", "SPACES_ACCESS_KEY_ID", "SPACES_SECRET_ACCESS_KEY"} {
+			if os.Getenv(name) == "" {
+				os.Setenv(name, "vcr-replay")
+			}
+		}
+	}
+
 	if v := os.Getenv("DIGITALOCEAN_TOKEN"); v == "" {
 		t.Fatal("DIGITALOCEAN_TOKEN must be set for acceptance tests")
 	}
@@ -100,6 +152,152 @@ func TestURLDefault(t *testing.T) {
 	}
 }
 
+func TestProviderConfigure_RetryWait(t *testing.T) {
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":               "12345",
+		"http_retry_wait_min": 10.0,
+		"http_retry_wait_max": 5.0,
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if !diags.HasError() {
+		t.Fatal("Expected an error when http_retry_wait_min is greater than http_retry_wait_max")
+	}
+
+	if path := diags[0].AttributePath; !path.Equals(cty.GetAttrPath("http_retry_wait_min")) {
+		t.Fatalf("Expected the error to point at http_retry_wait_min, got %#v", path)
+	}
+}
+
+func TestProviderConfigure_TLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"account":{"uuid":"abc123","status":"active"}}`)
+	}))
+	defer server.Close()
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := ioutil.WriteFile(caCertFile, caCert, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "untrusted",
+			raw:     map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name: "insecure_skip_verify",
+			raw:  map[string]interface{}{"insecure_skip_verify": true},
+		},
+		{
+			name: "ca_cert_file",
+			raw:  map[string]interface{}{"ca_cert_file": caCertFile},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.raw["token"] = "12345"
+			c.raw["api_endpoint"] = server.URL
+			c.raw["http_retry_max"] = 0
+
+			rawProvider := Provider()
+			diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(c.raw))
+			if diags.HasError() {
+				t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+			}
+
+			_, _, err := rawProvider.Meta().(*CombinedConfig).godoClient().Account.Get(context.Background())
+			if c.wantErr && err == nil {
+				t.Fatal("Expected the certificate of the server not to be trusted")
+			}
+			if !c.wantErr && err != nil {
+				t.Fatalf("Expected the request to succeed, got: %s", err)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_UserAgentSuffix(t *testing.T) {
+	userAgents := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents <- r.UserAgent()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"account":{"uuid":"abc123"}}`)
+	}))
+	defer server.Close()
+
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":             "12345",
+		"api_endpoint":      server.URL,
+		"spaces_endpoint":   server.URL,
+		"spaces_access_id":  "abcdef",
+		"spaces_secret_key": "xyzzy",
+		"http_retry_max":    0,
+		"user_agent_suffix": "pipeline/deploy-42",
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("provider configure failed: %s", diagnosticsToString(diags))
+	}
+	meta := rawProvider.Meta().(*CombinedConfig)
+
+	if _, _, err := meta.godoClient().Account.Get(context.Background()); err != nil {
+		t.Fatalf("Expected the request to succeed, got: %s", err)
+	}
+	if ua := <-userAgents; !strings.HasSuffix(ua, " pipeline/deploy-42") {
+		t.Errorf("Expected the User-Agent of the API client to end with the suffix, got %q", ua)
+	}
+
+	sess, err := meta.spacesClient("nyc3")
+	if err != nil {
+		t.Fatalf("Failed to create Spaces client: %s", err)
+	}
+	// The response is not a valid S3 one, only the request matters.
+	s3.New(sess).ListBuckets(&s3.ListBucketsInput{})
+	if ua := <-userAgents; !strings.HasSuffix(ua, " pipeline/deploy-42") {
+		t.Errorf("Expected the User-Agent of the Spaces client to end with the suffix, got %q", ua)
+	}
+}
+
+func TestProviderConfigure_CACertFileMissing(t *testing.T) {
+	rawProvider := Provider()
+	raw := map[string]interface{}{
+		"token":        "12345",
+		"ca_cert_file": filepath.Join(t.TempDir(), "missing.pem"),
+	}
+
+	diags := rawProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if !diags.HasError() {
+		t.Fatal("Expected an error when ca_cert_file does not exist")
+	}
+}
+
+func TestSpacesRegion(t *testing.T) {
+	config := &CombinedConfig{}
+	if _, err := config.spacesRegion(""); err == nil {
+		t.Error("Expected an error when neither region nor spaces_default_region are set")
+	}
+
+	config.spacesDefaultRegion = "SFO3"
+	if region, err := config.spacesRegion(""); err != nil || region != "sfo3" {
+		t.Errorf("Expected the default region sfo3, got %q (%v)", region, err)
+	}
+	if region, err := config.spacesRegion("ams3"); err != nil || region != "ams3" {
+		t.Errorf("Expected the region ams3, got %q (%v)", region, err)
+	}
+}
+
 func diagnosticsToString(diags diag.Diagnostics) string {
 	diagsAsStrings := make([]string, len(diags))
 	for i, diag := range diags {
@@ -138,6 +336,10 @@ func TestSpaceAPIDefaultEndpoint(t *testing.T) {
 	if *client.Config.Endpoint != expectedEndpoint {
 		t.Fatalf("Expected %s, got %s", expectedEndpoint, *client.Config.Endpoint)
 	}
+
+	if *client.Config.MaxRetries != 4 {
+		t.Fatalf("Expected the Spaces client to retry %d times, got %d", 4, *client.Config.MaxRetries)
+	}
 }
 
 func TestSpaceAPIEndpointOverride(t *testing.T) {
@@ -179,5 +381,8 @@ func randomTestName() string {
 }
 
 func randomName(prefix string, length int) string {
+	if vcrMode() != "" {
+		return prefix + vcrName(length)
+	}
 	return fmt.Sprintf("%s%s", prefix, acctest.RandString(length))
 }
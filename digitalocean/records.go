@@ -3,6 +3,8 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"log"
+
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -64,33 +66,20 @@ func getDigitalOceanRecords(meta interface{}, extra map[string]interface{}) ([]i
 		return nil, fmt.Errorf("unable to find `domain` key from query data")
 	}
 
-	var allRecords []interface{}
-
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
-	}
-
-	for {
-		records, resp, err := client.Domains.Records(context.Background(), domain, opts)
+	allRecords, err := listAllPages(context.Background(), func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		records, resp, err := client.Domains.Records(ctx, domain, opts)
 		if err != nil {
-			return nil, fmt.Errorf("Error retrieving records: %s", err)
-		}
-
-		for _, record := range records {
-			allRecords = append(allRecords, record)
-		}
-
-		if resp.Links == nil || resp.Links.IsLastPage() {
-			break
+			return nil, resp, err
 		}
 
-		page, err := resp.Links.CurrentPage()
-		if err != nil {
-			return nil, fmt.Errorf("Error retrieving projects: %s", err)
+		items := make([]interface{}, len(records))
+		for i, record := range records {
+			items[i] = record
 		}
-
-		opts.Page = page + 1
+		return items, resp, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving records: %s", err)
 	}
 
 	return allRecords, nil
@@ -123,3 +112,21 @@ func flattenDigitalOceanRecord(rawRecord interface{}, meta interface{}, extra ma
 
 	return flattenedRecord, nil
 }
+
+// deleteDigitalOceanRecord deletes a record of a domain, ignoring records
+// which are already gone.
+func deleteDigitalOceanRecord(client *godo.Client, domain string, id int) error {
+	log.Printf("[INFO] Deleting record: %s, %d", domain, id)
+	resp, err := client.Domains.DeleteRecord(context.Background(), domain, id)
+	if err != nil {
+		// If the record is somehow already destroyed, mark as
+		// successfully gone
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting record: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+const (
+	reservedIPv6BasePath    = "/v2/reserved_ipv6"
+	reservedIPv6Path        = reservedIPv6BasePath + "/%s"
+	reservedIPv6ActionsPath = reservedIPv6BasePath + "/%s/actions"
+)
+
+// reservedIPv6 is a static IPv6 address reserved to a region which can be
+// assigned to a Droplet in that region.
+type reservedIPv6 struct {
+	IP         string        `json:"ip"`
+	RegionSlug string        `json:"region_slug"`
+	ReservedAt string        `json:"reserved_at,omitempty"`
+	Droplet    *godo.Droplet `json:"droplet,omitempty"`
+}
+
+// URN returns the reserved IPv6 address in a valid DO API URN form.
+func (r reservedIPv6) URN() string {
+	return godo.ToURN("ReservedIPv6", r.IP)
+}
+
+type reservedIPv6CreateRequest struct {
+	RegionSlug string `json:"region_slug"`
+}
+
+type reservedIPv6Root struct {
+	ReservedIPv6 *reservedIPv6 `json:"reserved_ipv6"`
+}
+
+type reservedIPv6ActionRequest struct {
+	Type      string `json:"type"`
+	DropletID int    `json:"droplet_id,omitempty"`
+}
+
+type reservedIPv6ActionRoot struct {
+	Action *godo.Action `json:"action"`
+}
+
+// createReservedIPv6 reserves a new IPv6 address in a region.
+func createReservedIPv6(ctx context.Context, client *godo.Client, createRequest *reservedIPv6CreateRequest) (*reservedIPv6, *godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodPost, reservedIPv6BasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(reservedIPv6Root)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.ReservedIPv6, resp, nil
+}
+
+// getReservedIPv6 retrieves a reserved IPv6 address including the Droplet it
+// is assigned to, if any.
+func getReservedIPv6(ctx context.Context, client *godo.Client, ip string) (*reservedIPv6, *godo.Response, error) {
+	path := fmt.Sprintf(reservedIPv6Path, ip)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(reservedIPv6Root)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.ReservedIPv6, resp, nil
+}
+
+// deleteReservedIPv6 releases a reserved IPv6 address.
+func deleteReservedIPv6(ctx context.Context, client *godo.Client, ip string) (*godo.Response, error) {
+	path := fmt.Sprintf(reservedIPv6Path, ip)
+	req, err := client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+// assignReservedIPv6 assigns a reserved IPv6 address to a Droplet.
+func assignReservedIPv6(ctx context.Context, client *godo.Client, ip string, dropletID int) (*godo.Action, *godo.Response, error) {
+	return doReservedIPv6Action(ctx, client, ip, &reservedIPv6ActionRequest{Type: "assign", DropletID: dropletID})
+}
+
+// unassignReservedIPv6 unassigns a reserved IPv6 address from its Droplet.
+func unassignReservedIPv6(ctx context.Context, client *godo.Client, ip string) (*godo.Action, *godo.Response, error) {
+	return doReservedIPv6Action(ctx, client, ip, &reservedIPv6ActionRequest{Type: "unassign"})
+}
+
+func doReservedIPv6Action(ctx context.Context, client *godo.Client, ip string, action *reservedIPv6ActionRequest) (*godo.Action, *godo.Response, error) {
+	path := fmt.Sprintf(reservedIPv6ActionsPath, ip)
+	req, err := client.NewRequest(ctx, http.MethodPost, path, action)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(reservedIPv6ActionRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Action, resp, nil
+}
+
+func waitForReservedIPv6ActionCompleted(ctx context.Context, meta interface{}, ip string, actionID int) error {
+	log.Printf("[INFO] Waiting for Reserved IPv6 (%s) action %d to complete", ip, actionID)
+
+	config := meta.(*CombinedConfig)
+	refresh := func() (interface{}, string, error) {
+		action, _, err := config.godoClient().Actions.Get(ctx, actionID)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving Reserved IPv6 (%s) ActionId (%d): %s", ip, actionID, err)
+		}
+
+		log.Printf("[INFO] The Reserved IPv6 Action Status is %s", action.Status)
+		return action, action.Status, nil
+	}
+
+	_, err := config.poller().waitForState(ctx, 60*time.Minute, []string{"new", "in-progress"}, []string{"completed"}, refresh)
+	return err
+}
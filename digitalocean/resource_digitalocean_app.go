@@ -76,6 +76,9 @@ func resourceDigitalOceanAppCreate(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*CombinedConfig).godoClient()
 	appCreateRequest := &godo.AppCreateRequest{}
 	appCreateRequest.Spec = expandAppSpec(d.Get("spec").([]interface{}))
+	if err := validateAppImageSources(appCreateRequest.Spec); err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Printf("[DEBUG] App create request: %#v", appCreateRequest)
 	app, _, err := client.Apps.Create(context.Background(), appCreateRequest)
@@ -134,6 +137,9 @@ func resourceDigitalOceanAppUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChange("spec") {
 		appUpdateRequest := &godo.AppUpdateRequest{}
 		appUpdateRequest.Spec = expandAppSpec(d.Get("spec").([]interface{}))
+		if err := validateAppImageSources(appUpdateRequest.Spec); err != nil {
+			return diag.FromErr(err)
+		}
 
 		app, _, err := client.Apps.Update(context.Background(), d.Id(), appUpdateRequest)
 		if err != nil {
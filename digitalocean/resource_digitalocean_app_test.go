@@ -1088,3 +1088,51 @@ resource "digitalocean_app" "foobar" {
     }
   }
 }`
+
+func TestValidateAppImageSources(t *testing.T) {
+	cases := []struct {
+		spec     *godo.AppSpec
+		expected string
+	}{
+		{
+			spec: &godo.AppSpec{
+				Services: []*godo.AppServiceSpec{
+					{Name: "web", Image: &godo.ImageSourceSpec{RegistryType: godo.ImageSourceSpecRegistryType_DockerHub, Registry: "caddy", Repository: "caddy"}},
+					{Name: "api", GitHub: &godo.GitHubSourceSpec{Repo: "username/repo"}},
+				},
+				Workers: []*godo.AppWorkerSpec{
+					{Name: "queue", Image: &godo.ImageSourceSpec{RegistryType: godo.ImageSourceSpecRegistryType_DOCR, Repository: "queue"}},
+				},
+			},
+		},
+		{
+			spec: &godo.AppSpec{
+				Services: []*godo.AppServiceSpec{
+					{Name: "web", Image: &godo.ImageSourceSpec{RegistryType: godo.ImageSourceSpecRegistryType_DockerHub, Repository: "caddy"}},
+				},
+			},
+			expected: "the image of service web must set registry",
+		},
+		{
+			spec: &godo.AppSpec{
+				Jobs: []*godo.AppJobSpec{
+					{Name: "migrate", Image: &godo.ImageSourceSpec{RegistryType: godo.ImageSourceSpecRegistryType_DOCR, Registry: "example", Repository: "api"}},
+				},
+			},
+			expected: "the image of job migrate must not set registry",
+		},
+	}
+
+	for _, c := range cases {
+		err := validateAppImageSources(c.spec)
+		if c.expected == "" {
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.expected) {
+			t.Errorf("expected an error containing %q, got %v", c.expected, err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanBYOIPAddressAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanBYOIPAddressAssignmentCreate,
+		ReadContext:   resourceDigitalOceanBYOIPAddressAssignmentRead,
+		DeleteContext: resourceDigitalOceanBYOIPAddressAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanBYOIPAddressAssignmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"byoip_prefix_uuid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanBYOIPAddressAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	prefixUUID := d.Get("byoip_prefix_uuid").(string)
+	ipAddress := d.Get("ip_address").(string)
+	dropletID := d.Get("droplet_id").(int)
+
+	prefix, _, err := getBYOIPPrefix(context.Background(), client, prefixUUID)
+	if err != nil {
+		return diag.Errorf("Error retrieving BYOIP Prefix: %s", err)
+	}
+
+	_, network, err := net.ParseCIDR(prefix.Prefix)
+	if err != nil {
+		return diag.Errorf("Error parsing BYOIP Prefix (%s): %s", prefix.Prefix, err)
+	}
+	if !network.Contains(net.ParseIP(ipAddress)) {
+		return diag.Errorf("IP address %s is not part of the BYOIP Prefix %s", ipAddress, prefix.Prefix)
+	}
+
+	opts := &byoipAddressAssignmentCreateRequest{
+		IPAddress: ipAddress,
+		DropletID: dropletID,
+	}
+
+	log.Printf("[INFO] Assigning the BYOIP address (%s) to the Droplet %d", ipAddress, dropletID)
+	assignment, _, err := createBYOIPAddressAssignment(context.Background(), client, prefixUUID, opts)
+	if err != nil {
+		return diag.Errorf("Error assigning BYOIP address (%s) to the droplet: %s", ipAddress, err)
+	}
+
+	d.SetId(assignment.ID)
+	return resourceDigitalOceanBYOIPAddressAssignmentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanBYOIPAddressAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	prefixUUID := d.Get("byoip_prefix_uuid").(string)
+
+	assignment, resp, err := getBYOIPAddressAssignment(context.Background(), client, prefixUUID, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] BYOIP address assignment (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving BYOIP address assignment: %s", err)
+	}
+
+	d.Set("ip_address", assignment.IPAddress)
+	d.Set("droplet_id", assignment.DropletID)
+	d.Set("status", assignment.Status)
+	d.Set("created_at", assignment.CreatedAt)
+
+	return nil
+}
+
+func resourceDigitalOceanBYOIPAddressAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	prefixUUID := d.Get("byoip_prefix_uuid").(string)
+
+	log.Printf("[INFO] Unassigning the BYOIP address (%s) from the Droplet", d.Get("ip_address"))
+	resp, err := deleteBYOIPAddressAssignment(context.Background(), client, prefixUUID, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error unassigning BYOIP address (%s) from the droplet: %s", d.Get("ip_address"), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanBYOIPAddressAssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != 2 || s[0] == "" || s[1] == "" {
+		return nil, fmt.Errorf("Must use the UUID of the BYOIP prefix and the ID of the assignment joined with a comma (e.g. `prefix_uuid,assignment_id`)")
+	}
+
+	d.SetId(s[1])
+	d.Set("byoip_prefix_uuid", s[0])
+
+	return []*schema.ResourceData{d}, nil
+}
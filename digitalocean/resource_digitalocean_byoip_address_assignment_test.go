@@ -0,0 +1,124 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanBYOIPAddressAssignment_Basic(t *testing.T) {
+	cidr, signature := testAccPreCheckBYOIPPrefix(t)
+	name := randomTestName()
+
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil {
+		t.Fatalf("invalid DIGITALOCEAN_BYOIP_PREFIX: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBYOIPAddressAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanBYOIPAddressAssignmentConfig(cidr, signature, name, ip.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanBYOIPAddressAssignmentExists("digitalocean_byoip_address_assignment.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_byoip_address_assignment.foobar", "ip_address", ip.String()),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_byoip_address_assignment.foobar", "droplet_id",
+						"digitalocean_droplet.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_byoip_address_assignment.foobar", "byoip_prefix_uuid",
+						"digitalocean_byoip_prefix.foobar", "id"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_byoip_address_assignment.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["digitalocean_byoip_address_assignment.foobar"]
+					return fmt.Sprintf("%s,%s", rs.Primary.Attributes["byoip_prefix_uuid"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanBYOIPAddressAssignmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_byoip_address_assignment" {
+			continue
+		}
+
+		_, _, err := getBYOIPAddressAssignment(context.Background(), client, rs.Primary.Attributes["byoip_prefix_uuid"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("BYOIP address assignment still exists")
+		}
+	}
+
+	return testAccCheckDigitalOceanBYOIPPrefixDestroy(s)
+}
+
+func testAccCheckDigitalOceanBYOIPAddressAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No BYOIP address assignment ID is set")
+		}
+
+		dropletID, err := strconv.Atoi(rs.Primary.Attributes["droplet_id"])
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		assignment, _, err := getBYOIPAddressAssignment(context.Background(), client, rs.Primary.Attributes["byoip_prefix_uuid"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if assignment.IPAddress != rs.Primary.Attributes["ip_address"] || assignment.DropletID != dropletID {
+			return fmt.Errorf("Wrong BYOIP address assignment found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanBYOIPAddressAssignmentConfig(prefix, signature, name, ip string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_byoip_prefix" "foobar" {
+  prefix     = "%s"
+  signature  = "%s"
+  region     = "nyc3"
+  advertised = true
+}
+
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-20-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_byoip_address_assignment" "foobar" {
+  byoip_prefix_uuid = digitalocean_byoip_prefix.foobar.id
+  ip_address        = "%s"
+  droplet_id        = digitalocean_droplet.foobar.id
+}`, prefix, signature, name, ip)
+}
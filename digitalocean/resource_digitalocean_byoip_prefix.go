@@ -0,0 +1,202 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanBYOIPPrefix() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanBYOIPPrefixCreate,
+		ReadContext:   resourceDigitalOceanBYOIPPrefixRead,
+		UpdateContext: resourceDigitalOceanBYOIPPrefixUpdate,
+		DeleteContext: resourceDigitalOceanBYOIPPrefixDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "the IP prefix to bring to DigitalOcean in CIDR notation",
+			},
+
+			"signature": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the signed message proving ownership of the prefix",
+				// The API does not return the signature a prefix was
+				// validated with, it is unknown for imported prefixes.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					// DO API V2 region slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"advertised": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether DigitalOcean advertises the prefix",
+			},
+
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanBYOIPPrefixCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := &byoipPrefixCreateRequest{
+		Prefix:    d.Get("prefix").(string),
+		Signature: d.Get("signature").(string),
+		Region:    strings.ToLower(d.Get("region").(string)),
+	}
+
+	log.Printf("[DEBUG] BYOIP Prefix create request: %s", opts.Prefix)
+	prefix, _, err := createBYOIPPrefix(context.Background(), client, opts)
+	if err != nil {
+		return diag.Errorf("Error creating BYOIP Prefix: %s", err)
+	}
+
+	d.SetId(prefix.UUID)
+
+	log.Printf("[INFO] Waiting for BYOIP Prefix (%s) to be validated", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"new", "pending", "verifying"},
+		Target:     []string{byoipPrefixStatusActive},
+		Refresh:    byoipPrefixStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for BYOIP Prefix (%s) to become active: %s", d.Id(), err)
+	}
+
+	if d.Get("advertised").(bool) {
+		if _, _, err := updateBYOIPPrefix(context.Background(), client, d.Id(), &byoipPrefixUpdateRequest{Advertise: true}); err != nil {
+			return diag.Errorf("Error advertising BYOIP Prefix (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanBYOIPPrefixRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanBYOIPPrefixRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	prefix, resp, err := getBYOIPPrefix(context.Background(), client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] BYOIP Prefix (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving BYOIP Prefix: %s", err)
+	}
+
+	d.Set("uuid", prefix.UUID)
+	d.Set("prefix", prefix.Prefix)
+	d.Set("region", prefix.Region)
+	d.Set("advertised", prefix.Advertised)
+	d.Set("status", prefix.Status)
+	d.Set("failure_reason", prefix.FailureReason)
+
+	return nil
+}
+
+func resourceDigitalOceanBYOIPPrefixUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	if d.HasChange("advertised") {
+		opts := &byoipPrefixUpdateRequest{
+			Advertise: d.Get("advertised").(bool),
+		}
+
+		log.Printf("[DEBUG] BYOIP Prefix (%s) advertise: %t", d.Id(), opts.Advertise)
+		if _, _, err := updateBYOIPPrefix(context.Background(), client, d.Id(), opts); err != nil {
+			return diag.Errorf("Error updating BYOIP Prefix (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanBYOIPPrefixRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanBYOIPPrefixDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	log.Printf("[INFO] Deleting BYOIP Prefix: %s", d.Id())
+	resp, err := deleteBYOIPPrefix(context.Background(), client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error deleting BYOIP Prefix: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// byoipPrefixStateRefreshFunc returns the status of a BYOIP prefix and fails
+// fast when its validation has failed.
+func byoipPrefixStateRefreshFunc(client *godo.Client, uuid string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		prefix, _, err := getBYOIPPrefix(context.Background(), client, uuid)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving BYOIP Prefix: %s", err)
+		}
+
+		if prefix.Status == byoipPrefixStatusFailed {
+			return nil, "", fmt.Errorf("BYOIP Prefix validation failed: %s", prefix.FailureReason)
+		}
+
+		return prefix, prefix.Status, nil
+	}
+}
@@ -0,0 +1,146 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// BYOIP prefixes can only be validated for IP space owned by the account
+// running the tests, so the prefix and its signed ownership message must be
+// provided through the environment.
+func testAccPreCheckBYOIPPrefix(t *testing.T) (string, string) {
+	prefix := os.Getenv("DIGITALOCEAN_BYOIP_PREFIX")
+	signature := os.Getenv("DIGITALOCEAN_BYOIP_SIGNATURE")
+	if prefix == "" || signature == "" {
+		t.Skip("DIGITALOCEAN_BYOIP_PREFIX and DIGITALOCEAN_BYOIP_SIGNATURE must be set for BYOIP acceptance tests")
+	}
+
+	return prefix, signature
+}
+
+func TestAccDigitalOceanBYOIPPrefix_Basic(t *testing.T) {
+	var prefix byoipPrefix
+	cidr, signature := testAccPreCheckBYOIPPrefix(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBYOIPPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanBYOIPPrefixConfig_basic(cidr, signature, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanBYOIPPrefixExists("digitalocean_byoip_prefix.foobar", &prefix),
+					resource.TestCheckResourceAttr(
+						"digitalocean_byoip_prefix.foobar", "prefix", cidr),
+					resource.TestCheckResourceAttr(
+						"digitalocean_byoip_prefix.foobar", "region", "nyc3"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_byoip_prefix.foobar", "advertised", "false"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_byoip_prefix.foobar", "status", "active"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_byoip_prefix.foobar", "uuid"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanBYOIPPrefixConfig_basic(cidr, signature, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanBYOIPPrefixExists("digitalocean_byoip_prefix.foobar", &prefix),
+					resource.TestCheckResourceAttr(
+						"digitalocean_byoip_prefix.foobar", "advertised", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanBYOIPPrefixDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_byoip_prefix" {
+			continue
+		}
+
+		_, _, err := getBYOIPPrefix(context.Background(), client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("BYOIP Prefix still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanBYOIPPrefixExists(n string, prefix *byoipPrefix) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No BYOIP Prefix ID is set")
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		foundPrefix, _, err := getBYOIPPrefix(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundPrefix.UUID != rs.Primary.ID {
+			return fmt.Errorf("BYOIP Prefix not found")
+		}
+
+		*prefix = *foundPrefix
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanBYOIPPrefixConfig_basic(prefix string, signature string, advertised bool) string {
+	return fmt.Sprintf(`
+resource "digitalocean_byoip_prefix" "foobar" {
+  prefix     = "%s"
+  signature  = "%s"
+  region     = "nyc3"
+  advertised = %t
+}`, prefix, signature, advertised)
+}
+
+func TestResourceDigitalOceanBYOIPPrefixImportedDiff(t *testing.T) {
+	// Imported prefixes have no signature in their state, the API does not
+	// return it.
+	state := &terraform.InstanceState{
+		ID: "506f78a4-e098-11e5-ad9f-000f53306ae1",
+		Attributes: map[string]string{
+			"id":         "506f78a4-e098-11e5-ad9f-000f53306ae1",
+			"prefix":     "192.0.2.0/24",
+			"region":     "nyc3",
+			"advertised": "false",
+			"uuid":       "506f78a4-e098-11e5-ad9f-000f53306ae1",
+			"status":     "active",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"prefix":     "192.0.2.0/24",
+		"signature":  "signed-message",
+		"region":     "nyc3",
+		"advertised": false,
+	})
+
+	diff, err := resourceDigitalOceanBYOIPPrefix().Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected no diff for an imported prefix, got %#v", diff.Attributes)
+	}
+}
@@ -2,8 +2,11 @@ package digitalocean
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,6 +24,12 @@ func resourceDigitalOceanCDN() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -41,6 +50,24 @@ func resourceDigitalOceanCDNv1() map[string]*schema.Schema {
 			Optional: true,
 			Computed: true,
 		},
+		"manage_custom_domain": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			Description:   "create the Let's Encrypt certificate and the CNAME record of the custom domain",
+			RequiredWith:  []string{"custom_domain"},
+			ConflictsWith: []string{"certificate_name", "certificate_id"},
+		},
+		"custom_domain_zone": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "domain managed by DigitalOcean DNS holding the CNAME record of the custom domain",
+		},
+		"custom_domain_record_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "ID of the CNAME record of the custom domain",
+		},
 	}
 
 	for k, v := range resourceDigitalOceanCDNv0().Schema {
@@ -133,6 +160,17 @@ func resourceDigitalOceanCDNCreate(ctx context.Context, d *schema.ResourceData,
 		cdnRequest.CustomDomain = v.(string)
 	}
 
+	var managed *cdnManagedDomain
+	if d.Get("manage_custom_domain").(bool) {
+		cert, zone, err := createCDNManagedCertificate(ctx, meta, cdnRequest.CustomDomain, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		cdnRequest.CertificateID = cert.ID
+		managed = &cdnManagedDomain{zone: zone, certificateName: cert.Name}
+	}
+
 	if name, nameOk := d.GetOk("certificate_name"); nameOk {
 		certName := name.(string)
 		if certName != "" {
@@ -171,12 +209,29 @@ func resourceDigitalOceanCDNCreate(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[DEBUG] CDN create request: %#v", cdnRequest)
 	cdn, _, err := client.CDNs.Create(context.Background(), cdnRequest)
 	if err != nil {
-		return diag.Errorf("Error creating CDN: %s", err)
+		err = fmt.Errorf("Error creating CDN: %s", err)
+		if managed != nil {
+			err = managed.discard(client, err)
+		}
+		return diag.FromErr(err)
 	}
 
 	d.SetId(cdn.ID)
 	log.Printf("[INFO] CDN created, ID: %s", d.Id())
 
+	if managed != nil {
+		// The certificate is deleted along with the CDN, even if the record
+		// cannot be created.
+		d.Set("certificate_name", managed.certificateName)
+		d.Set("custom_domain_zone", managed.zone)
+
+		recordID, err := createCDNCustomDomainRecord(ctx, client, managed.zone, cdnRequest.CustomDomain, cdn.Endpoint)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("custom_domain_record_id", recordID)
+	}
+
 	return resourceDigitalOceanCDNRead(ctx, d, meta)
 }
 
@@ -232,7 +287,52 @@ func resourceDigitalOceanCDNUpdate(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[INFO] Updated TTL on CDN")
 	}
 
-	if d.HasChanges("certificate_id", "custom_domain", "certificate_name") {
+	// The certificate and the record of the previous custom domain are only
+	// deleted if they were managed and the custom domain is still managed.
+	// Otherwise they are left in place, as the CDN may still use them.
+	managed := d.Get("manage_custom_domain").(bool)
+	wasManaged, _ := d.GetChange("manage_custom_domain")
+
+	if managed && d.HasChanges("custom_domain", "manage_custom_domain") {
+		previous := &cdnManagedDomain{
+			zone:     d.Get("custom_domain_zone").(string),
+			recordID: d.Get("custom_domain_record_id").(int),
+		}
+		if wasManaged.(bool) {
+			previous.certificateName = d.Get("certificate_name").(string)
+		}
+
+		customDomain := d.Get("custom_domain").(string)
+		cert, zone, err := createCDNManagedCertificate(ctx, meta, customDomain, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, _, err = client.CDNs.UpdateCustomDomain(context.Background(), d.Id(), &godo.CDNUpdateCustomDomainRequest{
+			CustomDomain:  customDomain,
+			CertificateID: cert.ID,
+		})
+		if err != nil {
+			err = fmt.Errorf("Error updating CDN custom domain: %s", err)
+			return diag.FromErr((&cdnManagedDomain{certificateName: cert.Name}).discard(client, err))
+		}
+		d.Set("certificate_name", cert.Name)
+		log.Printf("[INFO] Updated custom domain/certificate on CDN")
+
+		if wasManaged.(bool) {
+			if err := previous.delete(ctx, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		d.Set("custom_domain_zone", zone)
+		d.Set("custom_domain_record_id", 0)
+
+		recordID, err := createCDNCustomDomainRecord(ctx, client, zone, customDomain, d.Get("endpoint").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("custom_domain_record_id", recordID)
+	} else if d.HasChanges("certificate_id", "custom_domain", "certificate_name") {
 		cdnUpdateRequest := &godo.CDNUpdateCustomDomainRequest{
 			CustomDomain: d.Get("custom_domain").(string),
 		}
@@ -255,19 +355,50 @@ func resourceDigitalOceanCDNUpdate(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[INFO] Updated custom domain/certificate on CDN")
 	}
 
+	var diags diag.Diagnostics
+	if !managed {
+		// The CDN may still use the certificate and the record, they are
+		// no longer tracked and have to be deleted manually if unused.
+		if wasManaged.(bool) {
+			oldCertName, _ := d.GetChange("certificate_name")
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The certificate and the CNAME record of the custom domain are no longer managed",
+				Detail: fmt.Sprintf("manage_custom_domain was unset, so certificate %s and CNAME record %d of %s were left in place. Delete them once the CDN endpoint no longer uses them.",
+					oldCertName, d.Get("custom_domain_record_id").(int), d.Get("custom_domain_zone").(string)),
+			})
+		}
+
+		d.Set("custom_domain_zone", "")
+		d.Set("custom_domain_record_id", 0)
+	}
+
 	d.Partial(false)
-	return resourceDigitalOceanCDNRead(ctx, d, meta)
+	return append(diags, resourceDigitalOceanCDNRead(ctx, d, meta)...)
 }
 
 func resourceDigitalOceanCDNDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*CombinedConfig).godoClient()
 	resourceId := d.Id()
 
-	_, err := client.CDNs.Delete(context.Background(), resourceId)
-	if err != nil {
+	// The CDN may have been deleted by a previous attempt that failed to
+	// delete the certificate of its custom domain.
+	resp, err := client.CDNs.Delete(context.Background(), resourceId)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
 		return diag.Errorf("Error deleting CDN: %s", err)
 	}
 
+	if d.Get("manage_custom_domain").(bool) {
+		managed := &cdnManagedDomain{
+			zone:            d.Get("custom_domain_zone").(string),
+			recordID:        d.Get("custom_domain_record_id").(int),
+			certificateName: d.Get("certificate_name").(string),
+		}
+		if err := managed.delete(ctx, client, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.SetId("")
 	log.Printf("[INFO] CDN deleted, ID: %s", resourceId)
 
@@ -2,14 +2,60 @@ package digitalocean
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("digitalocean_cdn", &resource.Sweeper{
+		Name: "digitalocean_cdn",
+		F:    testSweepCDNs,
+	})
+}
+
+func testSweepCDNs(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*CombinedConfig).godoClient()
+
+	opt := &godo.ListOptions{PerPage: 200}
+	cdns, _, err := client.CDNs.List(context.Background(), opt)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range cdns {
+		if strings.HasPrefix(c.Origin, "tf-cdn-test-bucket-") {
+			log.Printf("Destroying CDN %s", c.Origin)
+
+			if _, err := client.CDNs.Delete(context.Background(), c.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 const originSuffix = ".ams3.digitaloceanspaces.com"
 
 func TestAccDigitalOceanCDN_Create(t *testing.T) {
@@ -146,6 +192,45 @@ func TestAccDigitalOceanCDN_CustomDomain(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanCDN_ManagedCustomDomain(t *testing.T) {
+	spaceName := generateBucketName()
+	domain := randomTestName() + ".com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCDNDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanCDNConfig_ManagedCustomDomain(domain, spaceName, "static"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanCDNExists("digitalocean_cdn.space_cdn"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_cdn.space_cdn", "custom_domain", "static."+domain),
+					resource.TestCheckResourceAttr(
+						"digitalocean_cdn.space_cdn", "custom_domain_zone", domain),
+					resource.TestMatchResourceAttr(
+						"digitalocean_cdn.space_cdn", "certificate_name", regexp.MustCompile(`^cdn-static-`)),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_cdn.space_cdn", "custom_domain_record_id"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanCDNConfig_ManagedCustomDomain(domain, spaceName, "assets"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanCDNExists("digitalocean_cdn.space_cdn"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_cdn.space_cdn", "custom_domain", "assets."+domain),
+					resource.TestMatchResourceAttr(
+						"digitalocean_cdn.space_cdn", "certificate_name", regexp.MustCompile(`^cdn-assets-`)),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_cdn.space_cdn", "custom_domain_record_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanCDNDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
 
@@ -284,3 +369,78 @@ resource "digitalocean_cdn" "space_cdn" {
   custom_domain    = "foo.%s"
 }`, domain, domain, certName, spaceName, certName, domain, domain)
 }
+
+func testAccCheckDigitalOceanCDNConfig_ManagedCustomDomain(domain string, spaceName string, subdomain string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_domain" "foobar" {
+  name = "%s"
+}
+
+resource "digitalocean_spaces_bucket" "space" {
+  name   = "%s"
+  region = "sfo3"
+}
+
+resource "digitalocean_cdn" "space_cdn" {
+  origin               = digitalocean_spaces_bucket.space.bucket_domain_name
+  custom_domain        = "%s.${digitalocean_domain.foobar.name}"
+  manage_custom_domain = true
+}
+`, domain, spaceName, subdomain)
+}
+
+func TestResourceDigitalOceanCDNCreate_DiscardsManagedCertificate(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		certName string
+		deleted  []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/domains/example.com":
+			fmt.Fprint(w, `{"domain":{"name":"example.com","ttl":1800}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/certificates":
+			var req godo.CertificateRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			certName = req.Name
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"certificate":{"id":"892071a0-bb95-49bc-8021-3afd67a210bf","name":%q,"type":"lets_encrypt","state":"verified"}}`, certName)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/certificates/892071a0-bb95-49bc-8021-3afd67a210bf":
+			fmt.Fprintf(w, `{"certificate":{"id":"892071a0-bb95-49bc-8021-3afd67a210bf","name":%q,"type":"lets_encrypt","state":"verified"}}`, certName)
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/certificates":
+			fmt.Fprintf(w, `{"certificates":[{"id":"892071a0-bb95-49bc-8021-3afd67a210bf","name":%q,"type":"lets_encrypt","state":"verified"}],"links":{},"meta":{"total":1}}`, certName)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v2/certificates/"):
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/v2/certificates/"))
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/cdn/endpoints":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"id":"unprocessable_entity","message":"origin is invalid"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+		}
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	meta := &CombinedConfig{client: client, statePoller: newPoller(10*time.Millisecond, 10*time.Millisecond)}
+
+	d := schema.TestResourceDataRaw(t, resourceDigitalOceanCDN().Schema, map[string]interface{}{
+		"origin":               "foobar.nyc3.digitaloceanspaces.com",
+		"custom_domain":        "static.example.com",
+		"manage_custom_domain": true,
+	})
+
+	diags := resourceDigitalOceanCDNCreate(context.Background(), d, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "origin is invalid") {
+		t.Fatalf("expected the creation of the CDN to fail, got %v", diags)
+	}
+	if expected := []string{"892071a0-bb95-49bc-8021-3afd67a210bf"}; !reflect.DeepEqual(deleted, expected) {
+		t.Errorf("expected the certificate created for the custom domain to be deleted, got %v", deleted)
+	}
+}
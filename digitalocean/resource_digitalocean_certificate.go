@@ -8,6 +8,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,6 +18,7 @@ func resourceDigitalOceanCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanCertificateCreate,
 		ReadContext:   resourceDigitalOceanCertificateRead,
+		UpdateContext: resourceDigitalOceanCertificateUpdate,
 		DeleteContext: resourceDigitalOceanCertificateDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -24,6 +26,37 @@ func resourceDigitalOceanCertificate() *schema.Resource {
 
 		Schema: resourceDigitalOceanCertificateV1(),
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		// Rotating a custom certificate uploads it again, which changes the
+		// attributes of the certificate computed by the API. The keys are
+		// looked up in the diff as HasChange compares the hashes in the state
+		// with the raw values of the configuration.
+		CustomizeDiff: customdiff.If(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				if diff.Id() == "" {
+					return false
+				}
+				for _, key := range []string{"private_key", "leaf_certificate", "certificate_chain"} {
+					if len(diff.GetChangedKeysPrefix(key)) > 0 {
+						return true
+					}
+				}
+				return false
+			},
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				for _, key := range []string{"uuid", "sha1_fingerprint", "not_after"} {
+					if err := diff.SetNewComputed(key); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		),
+
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -66,7 +99,6 @@ func resourceDigitalOceanCertificateV0() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Sensitive:    true,
-				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 				StateFunc:    HashStringStateFunc(),
 				// In order to support older statefiles with fully saved private_key
@@ -78,7 +110,6 @@ func resourceDigitalOceanCertificateV0() *schema.Resource {
 			"leaf_certificate": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 				StateFunc:    HashStringStateFunc(),
 				// In order to support older statefiles with fully saved leaf_certificate
@@ -90,7 +121,6 @@ func resourceDigitalOceanCertificateV0() *schema.Resource {
 			"certificate_chain": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 				StateFunc:    HashStringStateFunc(),
 				// In order to support older statefiles with fully saved certificate_chain
@@ -224,17 +254,20 @@ func resourceDigitalOceanCertificateCreate(ctx context.Context, d *schema.Resour
 	err = d.Set("uuid", cert.ID)
 
 	log.Printf("[INFO] Waiting for certificate (%s) to have state 'verified'", cert.Name)
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"pending"},
-		Target:     []string{"verified"},
-		Refresh:    newCertificateStateRefreshFunc(d, meta),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
+	_, err = meta.(*CombinedConfig).poller().waitForState(ctx, d.Timeout(schema.TimeoutCreate), []string{"pending"}, []string{"verified"}, newCertificateStateRefreshFunc(d, meta))
+	if err != nil {
+		if certificateType != "lets_encrypt" {
+			return diag.Errorf("Error waiting for certificate (%s) to become active: %s", d.Get("name"), err)
+		}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return diag.Errorf("Error waiting for certificate (%s) to become active: %s", d.Get("name"), err)
+		// Let's Encrypt issues the certificate once it has verified that
+		// the domains are managed by DigitalOcean DNS, which is the usual
+		// cause of failures.
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error waiting for Let's Encrypt to issue certificate (%s): %s", d.Get("name"), err),
+			Detail:   letsEncryptDNSRequirements(meta, certReq.DNSNames),
+		}}
 	}
 
 	return resourceDigitalOceanCertificateRead(ctx, d, meta)
@@ -259,6 +292,13 @@ func resourceDigitalOceanCertificateRead(ctx context.Context, d *schema.Resource
 		return nil
 	}
 
+	// Let's Encrypt certificates are renewed by DigitalOcean before they
+	// expire, the renewed certificate keeping the name and domains of the
+	// previous one. Only the computed attributes change.
+	if old := d.Get("sha1_fingerprint").(string); old != "" && old != cert.SHA1Fingerprint {
+		log.Printf("[INFO] Certificate (%s) was renewed or replaced, its SHA1 fingerprint changed from %s to %s", d.Id(), old, cert.SHA1Fingerprint)
+	}
+
 	d.Set("name", cert.Name)
 	d.Set("uuid", cert.ID)
 	d.Set("type", cert.Type)
@@ -274,6 +314,64 @@ func resourceDigitalOceanCertificateRead(ctx context.Context, d *schema.Resource
 
 }
 
+// resourceDigitalOceanCertificateUpdate rotates a custom certificate without
+// downtime for the load balancers and CDN endpoints using it. Certificate
+// names are unique, so the new certificate and key are first uploaded under
+// a temporary name and swapped in for the old certificate. The old one is
+// then deleted, and the new one uploaded again under its name to replace the
+// temporary one.
+func resourceDigitalOceanCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	if d.Get("type").(string) != "custom" {
+		return diag.Errorf("Error rotating certificate (%s): only custom certificates can be rotated", d.Id())
+	}
+
+	old, err := findCertificateByName(client, d.Id())
+	if err != nil {
+		return diag.Errorf("Error retrieving Certificate: %s", err)
+	}
+	if old == nil {
+		return diag.Errorf("Error rotating certificate (%s): certificate not found", d.Id())
+	}
+
+	certReq, err := buildCertificateRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tempReq := *certReq
+	tempReq.Name = fmt.Sprintf("%s-rotation-%d", certReq.Name, time.Now().Unix())
+
+	log.Printf("[INFO] Rotating certificate (%s) through temporary certificate %s", d.Id(), tempReq.Name)
+	temp, err := createCertificateAndWait(ctx, meta, &tempReq, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("Error creating temporary certificate %s: %s", tempReq.Name, err)
+	}
+
+	if err := swapCertificate(ctx, meta, old.ID, temp.ID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("Error replacing certificate (%s) with temporary certificate %s: %s", d.Id(), temp.Name, err)
+	}
+	if _, err := client.Certificates.Delete(ctx, old.ID); err != nil {
+		return diag.Errorf("Error deleting certificate (%s) replaced by temporary certificate %s: %s", d.Id(), temp.Name, err)
+	}
+
+	cert, err := createCertificateAndWait(ctx, meta, certReq, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("Error creating certificate (%s), the load balancers and CDN endpoints use temporary certificate %s in the meantime: %s", d.Id(), temp.Name, err)
+	}
+	d.Set("uuid", cert.ID)
+
+	if err := swapCertificate(ctx, meta, temp.ID, cert.ID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("Error replacing temporary certificate %s with certificate (%s): %s", temp.Name, d.Id(), err)
+	}
+	if _, err := client.Certificates.Delete(ctx, temp.ID); err != nil {
+		return diag.Errorf("Error deleting temporary certificate %s: %s", temp.Name, err)
+	}
+
+	return resourceDigitalOceanCertificateRead(ctx, d, meta)
+}
+
 func resourceDigitalOceanCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*CombinedConfig).godoClient()
 
@@ -11,6 +11,10 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strings"
@@ -20,6 +24,7 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -117,6 +122,161 @@ func TestAccDigitalOceanCertificate_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanCertificate_Rotate(t *testing.T) {
+	var cert, rotated godo.Certificate
+	rInt := acctest.RandInt()
+	name := fmt.Sprintf("certificate-%d", rInt)
+	lbName := randomTestName()
+	privateKeyMaterial, leafCertMaterial, certChainMaterial := generateTestCertMaterial(t)
+	newPrivateKeyMaterial, newLeafCertMaterial, newCertChainMaterial := generateTestCertMaterial(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanCertificateConfig_loadBalancer(rInt, privateKeyMaterial, leafCertMaterial, certChainMaterial, lbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanCertificateExists("digitalocean_certificate.foobar", &cert),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_loadbalancer.foobar",
+						"forwarding_rule.*",
+						map[string]string{
+							"certificate_name": name,
+						},
+					),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanCertificateConfig_loadBalancer(rInt, newPrivateKeyMaterial, newLeafCertMaterial, newCertChainMaterial, lbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanCertificateExists("digitalocean_certificate.foobar", &rotated),
+					resource.TestCheckResourceAttr(
+						"digitalocean_certificate.foobar", "id", name),
+					resource.TestCheckResourceAttr(
+						"digitalocean_certificate.foobar", "leaf_certificate", HashString(fmt.Sprintf("%s\n", newLeafCertMaterial))),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_loadbalancer.foobar",
+						"forwarding_rule.*",
+						map[string]string{
+							"certificate_name": name,
+						},
+					),
+					func(s *terraform.State) error {
+						if rotated.ID == cert.ID {
+							return fmt.Errorf("expected certificate %s to be replaced", cert.ID)
+						}
+						if rotated.SHA1Fingerprint == cert.SHA1Fingerprint {
+							return fmt.Errorf("expected the fingerprint of certificate %s to change", name)
+						}
+						return resource.TestCheckOutput("certificate_uuid", rotated.ID)(s)
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestReplaceLoadBalancerCertificate(t *testing.T) {
+	lb := &loadBalancer{
+		LoadBalancer: godo.LoadBalancer{
+			ForwardingRules: []godo.ForwardingRule{
+				{EntryProtocol: "https", CertificateID: "old"},
+				{EntryProtocol: "http"},
+			},
+		},
+		Domains: []*loadBalancerDomain{
+			{Name: "example.com", CertificateID: "old"},
+			{Name: "example.org", CertificateID: "other"},
+		},
+	}
+
+	if !replaceLoadBalancerCertificate(lb, "old", "new") {
+		t.Fatal("expected the certificate to be reported as replaced")
+	}
+	if id := lb.ForwardingRules[0].CertificateID; id != "new" {
+		t.Errorf("expected the certificate of the forwarding rule to be replaced, got %q", id)
+	}
+	if lb.Domains[0].CertificateID != "new" || lb.Domains[1].CertificateID != "other" {
+		t.Errorf("expected only the old certificate of the domains to be replaced, got %q and %q", lb.Domains[0].CertificateID, lb.Domains[1].CertificateID)
+	}
+
+	if replaceLoadBalancerCertificate(lb, "old", "new") {
+		t.Error("expected a load balancer not using the certificate to be left untouched")
+	}
+}
+
+func TestPreferCertificate(t *testing.T) {
+	renewed := &godo.Certificate{State: "verified", NotAfter: "2021-12-01T00:00:00Z"}
+	previous := &godo.Certificate{State: "verified", NotAfter: "2021-09-01T00:00:00Z"}
+	pending := &godo.Certificate{State: "pending", NotAfter: "2022-01-01T00:00:00Z"}
+
+	if !preferCertificate(previous, nil) {
+		t.Error("expected the first certificate found to be used")
+	}
+	if !preferCertificate(renewed, previous) || preferCertificate(previous, renewed) {
+		t.Error("expected the certificate expiring last to be used")
+	}
+	if !preferCertificate(renewed, pending) || preferCertificate(pending, renewed) {
+		t.Error("expected a verified certificate to be used rather than a pending one")
+	}
+}
+
+func TestLetsEncryptDNSRequirement(t *testing.T) {
+	cases := []struct {
+		domain      string
+		zone        string
+		nameServers []string
+		expected    string
+	}{
+		{
+			domain:   "www.example.com",
+			expected: "www.example.com is not managed by DigitalOcean DNS",
+		},
+		{
+			domain:      "www.example.com",
+			zone:        "example.com",
+			nameServers: []string{"ns1.example.net.", "ns2.example.net."},
+			expected:    "example.com is delegated to ns1.example.net., ns2.example.net., point its NS records to ns1.digitalocean.com",
+		},
+		{
+			domain:      "www.example.com",
+			zone:        "example.com",
+			nameServers: []string{"ns1.digitalocean.com.", "ns2.digitalocean.com."},
+		},
+		{
+			domain: "www.example.com",
+			zone:   "example.com",
+		},
+	}
+
+	for _, c := range cases {
+		actual := letsEncryptDNSRequirement(c.domain, c.zone, c.nameServers)
+		if c.expected == "" && actual != "" {
+			t.Errorf("expected no requirement for %s in %q delegated to %v, got %q", c.domain, c.zone, c.nameServers, actual)
+		}
+		if !strings.Contains(actual, c.expected) {
+			t.Errorf("expected the requirement for %s in %q delegated to %v to contain %q, got %q", c.domain, c.zone, c.nameServers, c.expected, actual)
+		}
+	}
+}
+
+func TestCertificateDomainZones(t *testing.T) {
+	cases := map[string][]string{
+		"example.com":         {"example.com"},
+		"www.example.com.":    {"www.example.com", "example.com"},
+		"*.www.example.co.uk": {"www.example.co.uk", "example.co.uk", "co.uk"},
+		"localhost":           nil,
+	}
+
+	for domain, expected := range cases {
+		if actual := certificateDomainZones(domain); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("expected the zones of %s to be %v, got %v", domain, expected, actual)
+		}
+	}
+}
+
 func TestAccDigitalOceanCertificate_ExpectedErrors(t *testing.T) {
 	rInt := acctest.RandInt()
 	privateKeyMaterial, leafCertMaterial, certChainMaterial := generateTestCertMaterial(t)
@@ -273,6 +433,27 @@ EOF
 }`, rInt, privateKeyMaterial, leafCert, certChain)
 }
 
+func testAccCheckDigitalOceanCertificateConfig_loadBalancer(rInt int, privateKeyMaterial, leafCert, certChain, lbName string) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port       = 443
+    entry_protocol   = "https"
+    target_port      = 80
+    target_protocol  = "http"
+    certificate_name = digitalocean_certificate.foobar.name
+  }
+}
+
+output "certificate_uuid" {
+  value = digitalocean_certificate.foobar.uuid
+}`, testAccCheckDigitalOceanCertificateConfig_basic(rInt, privateKeyMaterial, leafCert, certChain), lbName)
+}
+
 func testAccCheckDigitalOceanCertificateConfig_customNoLeaf(rInt int, privateKeyMaterial, certChain string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_certificate" "foobar" {
@@ -306,3 +487,97 @@ resource "digitalocean_certificate" "foobar" {
   type = "lets_encrypt"
 }`, rInt)
 }
+
+func TestResourceDigitalOceanCertificateRotationDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "certificate-1",
+		Attributes: map[string]string{
+			"id":               "certificate-1",
+			"name":             "certificate-1",
+			"type":             "custom",
+			"private_key":      HashString("old-key"),
+			"leaf_certificate": HashString("old-cert"),
+			"uuid":             "a8cf3b1f-1fe7-4c06-8d24-ff1ba34a4a1a",
+			"sha1_fingerprint": "0b6b9b9c2ad6b7d8b3d1b8c5c0b6b8f1b2a3a4a5",
+			"not_after":        "2031-01-01T00:00:00Z",
+		},
+	}
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		computed bool
+	}{
+		{
+			name:     "unchanged",
+			config:   map[string]interface{}{"name": "certificate-1", "private_key": "old-key", "leaf_certificate": "old-cert"},
+			computed: false,
+		},
+		{
+			name:     "rotated",
+			config:   map[string]interface{}{"name": "certificate-1", "private_key": "new-key", "leaf_certificate": "new-cert"},
+			computed: true,
+		},
+	}
+
+	for _, c := range cases {
+		diff, err := resourceDigitalOceanCertificate().Diff(context.Background(), state, terraform.NewResourceConfigRaw(c.config), nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+
+		for _, key := range []string{"uuid", "sha1_fingerprint", "not_after"} {
+			computed := diff != nil && diff.Attributes[key] != nil && diff.Attributes[key].NewComputed
+			if computed != c.computed {
+				t.Errorf("%s: expected %s to be computed: %t, got %t", c.name, key, c.computed, computed)
+			}
+		}
+	}
+}
+
+func TestResourceDigitalOceanCertificateCreate_LetsEncryptTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/certificates":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"certificate":{"id":"892071a0-bb95-49bc-8021-3afd67a210bf","name":"le-certificate","type":"lets_encrypt","state":"pending","dns_names":["www.example.com"]}}`)
+		case "/v2/certificates/892071a0-bb95-49bc-8021-3afd67a210bf":
+			fmt.Fprint(w, `{"certificate":{"id":"892071a0-bb95-49bc-8021-3afd67a210bf","name":"le-certificate","type":"lets_encrypt","state":"pending","dns_names":["www.example.com"]}}`)
+		case "/v2/domains/example.com":
+			fmt.Fprint(w, `{"domain":{"name":"example.com","ttl":1800}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+		}
+	}))
+	defer server.Close()
+
+	defer func(f func(context.Context, string) ([]*net.NS, error)) { lookupNS = f }(lookupNS)
+	lookupNS = func(ctx context.Context, name string) ([]*net.NS, error) {
+		return []*net.NS{{Host: "ns1.digitalocean.com."}}, nil
+	}
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	meta := &CombinedConfig{client: client, statePoller: newPoller(10*time.Millisecond, 10*time.Millisecond)}
+
+	d := schema.TestResourceDataRaw(t, resourceDigitalOceanCertificate().Schema, map[string]interface{}{
+		"name":    "le-certificate",
+		"type":    "lets_encrypt",
+		"domains": []interface{}{"www.example.com"},
+	})
+
+	// The create context expires while waiting for the certificate, the
+	// diagnosis must still be able to look up the domains.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	diags := resourceDigitalOceanCertificateCreate(ctx, d, meta)
+	if !diags.HasError() {
+		t.Fatal("expected waiting for the certificate to fail")
+	}
+	if detail := diags[0].Detail; !strings.Contains(detail, "The domains are managed by DigitalOcean DNS") {
+		t.Errorf("expected the domains to be found managed by DigitalOcean DNS, got %q", detail)
+	}
+}
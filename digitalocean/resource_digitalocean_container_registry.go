@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -39,6 +41,16 @@ func resourceDigitalOceanContainerRegistry() *schema.Resource {
 					"professional",
 				}, false),
 			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					// DO API V2 region slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validation.StringInSlice(containerRegistryRegions, true),
+			},
 			"endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,6 +59,36 @@ func resourceDigitalOceanContainerRegistry() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"storage_usage_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"storage_usage_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"included_storage_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"included_bandwidth_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			// Registries are migrated to another region by DigitalOcean, the
+			// new region is picked up on refresh. Changing it here would
+			// require destroying the registry along with all of its images.
+			if diff.Id() != "" && diff.HasChange("region") {
+				o, n := diff.GetChange("region")
+				if o.(string) != "" && n.(string) != "" {
+					return fmt.Errorf("The region of container registry %s can not be changed from %s to %s, contact DigitalOcean support to migrate it", diff.Id(), o, n)
+				}
+			}
+
+			return nil
 		},
 	}
 }
@@ -55,13 +97,16 @@ func resourceDigitalOceanContainerRegistryCreate(ctx context.Context, d *schema.
 	client := meta.(*CombinedConfig).godoClient()
 
 	// Build up our creation options
-	opts := &godo.RegistryCreateRequest{
-		Name:                 d.Get("name").(string),
-		SubscriptionTierSlug: d.Get("subscription_tier_slug").(string),
+	opts := &containerRegistryCreateRequest{
+		RegistryCreateRequest: godo.RegistryCreateRequest{
+			Name:                 d.Get("name").(string),
+			SubscriptionTierSlug: d.Get("subscription_tier_slug").(string),
+		},
+		Region: strings.ToLower(d.Get("region").(string)),
 	}
 
 	log.Printf("[DEBUG] Container Registry create configuration: %#v", opts)
-	reg, _, err := client.Registry.Create(context.Background(), opts)
+	reg, _, err := createContainerRegistry(context.Background(), client, opts)
 	if err != nil {
 		return diag.Errorf("Error creating container registry: %s", err)
 	}
@@ -75,7 +120,7 @@ func resourceDigitalOceanContainerRegistryCreate(ctx context.Context, d *schema.
 func resourceDigitalOceanContainerRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*CombinedConfig).godoClient()
 
-	reg, resp, err := client.Registry.Get(context.Background())
+	reg, resp, err := getContainerRegistry(context.Background(), client)
 	if err != nil {
 		// If the registry is somehow already destroyed, mark as
 		// successfully gone
@@ -89,14 +134,19 @@ func resourceDigitalOceanContainerRegistryRead(ctx context.Context, d *schema.Re
 
 	d.SetId(reg.Name)
 	d.Set("name", reg.Name)
+	d.Set("region", reg.Region)
 	d.Set("endpoint", fmt.Sprintf("%s/%s", RegistryHostname, reg.Name))
 	d.Set("server_url", RegistryHostname)
+	d.Set("storage_usage_bytes", int(reg.StorageUsageBytes))
+	d.Set("storage_usage_updated_at", reg.StorageUsageBytesUpdatedAt.UTC().Format(time.RFC3339))
 
 	sub, _, err := client.Registry.GetSubscription(context.Background())
 	if err != nil {
 		return diag.Errorf("Error retrieving container registry subscription: %s", err)
 	}
 	d.Set("subscription_tier_slug", sub.Tier.Slug)
+	d.Set("included_storage_bytes", int(sub.Tier.IncludedStorageBytes))
+	d.Set("included_bandwidth_bytes", int(sub.Tier.IncludedBandwidthBytes))
 
 	return nil
 }
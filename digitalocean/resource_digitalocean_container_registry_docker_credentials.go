@@ -41,6 +41,13 @@ func resourceDigitalOceanContainerRegistryDockerCredentials() *schema.Resource {
 				Default:      expirySecondsDefault,
 				ValidateFunc: validation.IntBetween(0, expirySecondsDefault),
 			},
+			"renew_before_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "regenerate the credentials when they expire within this number of seconds",
+			},
 			"docker_credentials": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -51,6 +58,17 @@ func resourceDigitalOceanContainerRegistryDockerCredentials() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			expirySeconds := diff.Get("expiry_seconds").(int)
+			renewBeforeSeconds := diff.Get("renew_before_seconds").(int)
+
+			if renewBeforeSeconds >= expirySeconds && renewBeforeSeconds > 0 {
+				return fmt.Errorf("`renew_before_seconds` (%d) must be lower than `expiry_seconds` (%d)", renewBeforeSeconds, expirySeconds)
+			}
+
+			return nil
+		},
 	}
 }
 
@@ -133,6 +151,7 @@ func generateDockerCredentials(readWrite bool, expirySeconds int, client *godo.C
 
 func updateExpiredDockerCredentials(d *schema.ResourceData, readWrite bool, client *godo.Client) error {
 	expirySeconds := d.Get("expiry_seconds").(int)
+	renewBeforeSeconds := d.Get("renew_before_seconds").(int)
 	expirationTime := d.Get("credential_expiration_time").(string)
 	d.Set("expiry_seconds", expirySeconds)
 
@@ -143,7 +162,9 @@ func updateExpiredDockerCredentials(d *schema.ResourceData, readWrite bool, clie
 			return err
 		}
 
-		if expirationTime.Before(currentTime) {
+		// Credentials are regenerated ahead of their expiration so that their
+		// consumers, e.g. Kubernetes pull secrets, are updated in time.
+		if expirationTime.Before(currentTime.Add(time.Second * time.Duration(renewBeforeSeconds))) {
 			dockerConfigJSON, err := generateDockerCredentials(readWrite, expirySeconds, client)
 			if err != nil {
 				return err
@@ -3,7 +3,9 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -68,6 +70,65 @@ func TestAccDigitalOceanContainerRegistryDockerCredentials_withExpiry(t *testing
 	})
 }
 
+func TestAccDigitalOceanContainerRegistryDockerCredentials_renewBefore(t *testing.T) {
+	var reg godo.Registry
+	var expirationTime string
+	name := randomTestName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanContainerRegistryDockerCredentialsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanContainerRegistryDockerCredentialsConfig_renewBefore, name, 3600),
+				ExpectError: regexp.MustCompile("`renew_before_seconds` \\(3600\\) must be lower than `expiry_seconds` \\(3600\\)"),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanContainerRegistryDockerCredentialsConfig_renewBefore, name, 3590),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanContainerRegistryDockerCredentialsExists("digitalocean_container_registry.foobar", &reg),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry_docker_credentials.foobar", "renew_before_seconds", "3590"),
+					testAccCheckDigitalOceanContainerRegistryDockerCredentialsExpiration(
+						"digitalocean_container_registry_docker_credentials.foobar", &expirationTime, false),
+				),
+			},
+			{
+				// The credentials expire within renew_before_seconds once 10
+				// seconds have passed and are regenerated on refresh.
+				PreConfig: func() { time.Sleep(15 * time.Second) },
+				Config:    fmt.Sprintf(testAccCheckDigitalOceanContainerRegistryDockerCredentialsConfig_renewBefore, name, 3590),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanContainerRegistryDockerCredentialsExpiration(
+						"digitalocean_container_registry_docker_credentials.foobar", &expirationTime, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDigitalOceanContainerRegistryDockerCredentialsExpiration records
+// the expiration time of the credentials, checking that it changed since the
+// previous call when renewed is set.
+func testAccCheckDigitalOceanContainerRegistryDockerCredentialsExpiration(n string, expirationTime *string, renewed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["credential_expiration_time"]
+		if renewed && current == *expirationTime {
+			return fmt.Errorf("Docker credentials were not renewed, expiration time is still %s", current)
+		}
+
+		*expirationTime = current
+
+		return nil
+	}
+}
+
 func testAccCheckDigitalOceanContainerRegistryDockerCredentialsDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
 
@@ -147,3 +208,15 @@ resource "digitalocean_container_registry_docker_credentials" "foobar" {
 	write = true
 	expiry_seconds = 3600
 }`
+
+var testAccCheckDigitalOceanContainerRegistryDockerCredentialsConfig_renewBefore = `
+resource "digitalocean_container_registry" "foobar" {
+	name                   = "%s"
+	subscription_tier_slug = "basic"
+}
+
+resource "digitalocean_container_registry_docker_credentials" "foobar" {
+	registry_name = digitalocean_container_registry.foobar.name
+	expiry_seconds = 3600
+	renew_before_seconds = %d
+}`
@@ -0,0 +1,180 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanContainerRegistryRepositoryTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanContainerRegistryRepositoryTagCreate,
+		ReadContext:   resourceDigitalOceanContainerRegistryRepositoryTagRead,
+		UpdateContext: resourceDigitalOceanContainerRegistryRepositoryTagUpdate,
+		DeleteContext: resourceDigitalOceanContainerRegistryRepositoryTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanContainerRegistryRepositoryTagImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"repository": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"tag": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"delete_manifest": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether to delete the manifest the tag points to, along with its other tags, instead of only the tag",
+			},
+
+			"manifest_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"compressed_size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanContainerRegistryRepositoryTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	registryName := d.Get("registry_name").(string)
+	repository := d.Get("repository").(string)
+	name := d.Get("tag").(string)
+
+	// Tags are created by pushing images, the resource only takes over an
+	// existing tag.
+	tag, _, err := findContainerRegistryRepositoryTag(client, registryName, repository, name)
+	if err != nil {
+		return diag.Errorf("Error retrieving container registry repository tags: %s", err)
+	}
+	if tag == nil {
+		return diag.Errorf("Tag %s not found in repository %s of container registry %s", name, repository, registryName)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s", registryName, repository, name))
+
+	return resourceDigitalOceanContainerRegistryRepositoryTagRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanContainerRegistryRepositoryTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	tag, resp, err := findContainerRegistryRepositoryTag(client, d.Get("registry_name").(string), d.Get("repository").(string), d.Get("tag").(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Container registry repository (%s) not found", d.Get("repository"))
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving container registry repository tags: %s", err)
+	}
+
+	if tag == nil {
+		log.Printf("[WARN] Container registry repository tag (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("manifest_digest", tag.ManifestDigest)
+	d.Set("compressed_size_bytes", int(tag.CompressedSizeBytes))
+	d.Set("size_bytes", int(tag.SizeBytes))
+	d.Set("updated_at", tag.UpdatedAt.UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceDigitalOceanContainerRegistryRepositoryTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only delete_manifest can be updated, which is used on deletion.
+	return resourceDigitalOceanContainerRegistryRepositoryTagRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanContainerRegistryRepositoryTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	registryName := d.Get("registry_name").(string)
+	repository := d.Get("repository").(string)
+
+	if d.Get("delete_manifest").(bool) {
+		digest := d.Get("manifest_digest").(string)
+
+		log.Printf("[INFO] Deleting container registry repository manifest: %s", digest)
+		resp, err := client.Registry.DeleteManifest(context.Background(), registryName, repository, digest)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+
+			return diag.Errorf("Error deleting container registry repository manifest: %s", err)
+		}
+	} else {
+		log.Printf("[INFO] Deleting container registry repository tag: %s", d.Id())
+		resp, err := client.Registry.DeleteTag(context.Background(), registryName, repository, d.Get("tag").(string))
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+
+			return diag.Errorf("Error deleting container registry repository tag: %s", err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanContainerRegistryRepositoryTagImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != 3 || s[0] == "" || s[1] == "" || s[2] == "" {
+		return nil, fmt.Errorf("invalid container registry repository tag ID, expected <registry name>,<repository>,<tag>: %s", d.Id())
+	}
+
+	d.Set("registry_name", s[0])
+	d.Set("repository", s[1])
+	d.Set("tag", s[2])
+	d.Set("delete_manifest", false)
+
+	return []*schema.ResourceData{d}, nil
+}
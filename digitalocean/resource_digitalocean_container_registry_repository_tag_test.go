@@ -0,0 +1,72 @@
+package digitalocean
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The tag is deleted when the test completes, it must point to an image
+// pushed for the test only.
+func TestAccDigitalOceanContainerRegistryRepositoryTag_Basic(t *testing.T) {
+	registryName := os.Getenv("DIGITALOCEAN_REGISTRY_NAME")
+	repository := os.Getenv("DIGITALOCEAN_REGISTRY_REPOSITORY")
+	tag := os.Getenv("DIGITALOCEAN_REGISTRY_DISPOSABLE_TAG")
+	if registryName == "" || repository == "" || tag == "" {
+		t.Skip("DIGITALOCEAN_REGISTRY_NAME, DIGITALOCEAN_REGISTRY_REPOSITORY and DIGITALOCEAN_REGISTRY_DISPOSABLE_TAG must be set to test repository tags")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanContainerRegistryRepositoryTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanContainerRegistryRepositoryTagConfig(registryName, repository, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry_repository_tag.foobar", "id", fmt.Sprintf("%s,%s,%s", registryName, repository, tag)),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registry_repository_tag.foobar", "manifest_digest"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registry_repository_tag.foobar", "updated_at"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_container_registry_repository_tag.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanContainerRegistryRepositoryTagDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_container_registry_repository_tag" {
+			continue
+		}
+
+		tag, _, err := findContainerRegistryRepositoryTag(client, rs.Primary.Attributes["registry_name"],
+			rs.Primary.Attributes["repository"], rs.Primary.Attributes["tag"])
+		if err == nil && tag != nil {
+			return fmt.Errorf("Container registry repository tag still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanContainerRegistryRepositoryTagConfig(registryName, repository, tag string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_container_registry_repository_tag" "foobar" {
+  registry_name = "%s"
+  repository    = "%s"
+  tag           = "%s"
+}`, registryName, repository, tag)
+}
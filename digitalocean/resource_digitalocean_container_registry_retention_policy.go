@@ -0,0 +1,240 @@
+package digitalocean
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanContainerRegistryRetentionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanContainerRegistryRetentionPolicyApply,
+		ReadContext:   resourceDigitalOceanContainerRegistryRetentionPolicyRead,
+		UpdateContext: resourceDigitalOceanContainerRegistryRetentionPolicyApply,
+		DeleteContext: resourceDigitalOceanContainerRegistryRetentionPolicyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"repositories": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "the repositories the policy applies to, all of the repositories of the registry if not set",
+			},
+
+			"keep_last_tags": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				AtLeastOneOf: []string{"keep_last_tags", "untagged_manifest_max_age_days"},
+				Description:  "the number of most recently updated tags to keep in each repository",
+			},
+
+			"untagged_manifest_max_age_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				AtLeastOneOf: []string{"keep_last_tags", "untagged_manifest_max_age_days"},
+				Description:  "the age in days after which untagged manifests are deleted",
+			},
+
+			"garbage_collection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "whether to run a garbage collection to reclaim the storage of the deleted images",
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "arbitrary values which apply the policy again when changed",
+			},
+
+			"pruned_tag_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"pruned_manifest_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"garbage_collection_uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"freed_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"applied_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceDigitalOceanContainerRegistryRetentionPolicyApply prunes the
+// registry according to the policy. The API has no notion of retention
+// policies, so it is applied when the resource is created and whenever its
+// arguments or triggers change.
+func resourceDigitalOceanContainerRegistryRetentionPolicyApply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	registryName := d.Get("registry_name").(string)
+	keep := d.Get("keep_last_tags").(int)
+	maxAgeDays := d.Get("untagged_manifest_max_age_days").(int)
+
+	var repositories []string
+	if v, ok := d.GetOk("repositories"); ok {
+		for _, repository := range v.(*schema.Set).List() {
+			repositories = append(repositories, repository.(string))
+		}
+	} else {
+		rawRepositories, err := getDigitalOceanContainerRegistryRepositories(meta, map[string]interface{}{"registry_name": registryName})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, rawRepository := range rawRepositories {
+			repositories = append(repositories, rawRepository.(*godo.Repository).Name)
+		}
+	}
+
+	prunedTags, prunedManifests := 0, 0
+	for _, repository := range repositories {
+		if keep > 0 {
+			rawTags, err := getDigitalOceanContainerRegistryRepositoryTags(meta, map[string]interface{}{
+				"registry_name": registryName,
+				"repository":    repository,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			tags := make([]*godo.RepositoryTag, 0, len(rawTags))
+			for _, rawTag := range rawTags {
+				tags = append(tags, rawTag.(*godo.RepositoryTag))
+			}
+
+			for _, tag := range containerRegistryTagsToPrune(tags, keep) {
+				log.Printf("[INFO] Deleting container registry repository tag: %s:%s", repository, tag.Tag)
+				resp, err := client.Registry.DeleteTag(context.Background(), registryName, repository, tag.Tag)
+				if err != nil && (resp == nil || resp.StatusCode != 404) {
+					return diag.Errorf("Error deleting container registry repository tag %s:%s: %s", repository, tag.Tag, err)
+				}
+				prunedTags++
+			}
+		}
+
+		if maxAgeDays > 0 {
+			manifests, err := listContainerRegistryManifests(context.Background(), client, registryName, repository)
+			if err != nil {
+				return diag.Errorf("Error retrieving container registry repository manifests: %s", err)
+			}
+
+			maxAge := time.Duration(maxAgeDays) * 24 * time.Hour
+			for _, manifest := range containerRegistryManifestsToPrune(manifests, maxAge, time.Now()) {
+				log.Printf("[INFO] Deleting container registry repository manifest: %s@%s", repository, manifest.Digest)
+				resp, err := client.Registry.DeleteManifest(context.Background(), registryName, repository, manifest.Digest)
+				if err != nil && (resp == nil || resp.StatusCode != 404) {
+					return diag.Errorf("Error deleting container registry repository manifest %s@%s: %s", repository, manifest.Digest, err)
+				}
+				prunedManifests++
+			}
+		}
+	}
+
+	d.SetId(registryName)
+	d.Set("pruned_tag_count", prunedTags)
+	d.Set("pruned_manifest_count", prunedManifests)
+	d.Set("garbage_collection_uuid", "")
+	d.Set("freed_bytes", 0)
+	d.Set("applied_at", time.Now().UTC().Format(time.RFC3339))
+
+	if d.Get("garbage_collection").(bool) && prunedTags+prunedManifests > 0 {
+		// Untagged manifests are deleted above according to their age, the
+		// garbage collection only removes the blobs they no longer reference.
+		gc, _, err := client.Registry.StartGarbageCollection(context.Background(), registryName, &godo.StartGarbageCollectionRequest{
+			Type: godo.GCTypeUnreferencedBlobsOnly,
+		})
+		if err != nil {
+			return diag.Errorf("Error starting garbage collection of container registry %s: %s", registryName, err)
+		}
+		d.Set("garbage_collection_uuid", gc.UUID)
+
+		timeout := d.Timeout(schema.TimeoutCreate)
+		if !d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutUpdate)
+		}
+
+		log.Printf("[INFO] Waiting for garbage collection (%s) of container registry %s", gc.UUID, registryName)
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{garbageCollectionStatusRunning},
+			Target:     []string{garbageCollectionStatusSucceeded},
+			Refresh:    garbageCollectionStateRefreshFunc(client, registryName, gc.UUID),
+			Timeout:    timeout,
+			MinTimeout: 10 * time.Second,
+		}
+		result, err := stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return diag.Errorf("Error waiting for garbage collection (%s) of container registry %s: %s", gc.UUID, registryName, err)
+		}
+		d.Set("freed_bytes", int(result.(*godo.GarbageCollection).FreedBytes))
+	}
+
+	return resourceDigitalOceanContainerRegistryRetentionPolicyRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanContainerRegistryRetentionPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	reg, resp, err := getContainerRegistry(context.Background(), client)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Container registry (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving container registry: %s", err)
+	}
+
+	if reg.Name != d.Id() {
+		log.Printf("[WARN] Container registry (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanContainerRegistryRetentionPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Deleted images can not be restored, removing the policy only stops
+	// applying it.
+	d.SetId("")
+	return nil
+}
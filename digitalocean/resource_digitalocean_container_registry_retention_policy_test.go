@@ -0,0 +1,106 @@
+package digitalocean
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestContainerRegistryTagsToPrune(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	tags := []*godo.RepositoryTag{
+		{Tag: "v1", UpdatedAt: now.Add(-72 * time.Hour)},
+		{Tag: "v3", UpdatedAt: now.Add(-24 * time.Hour)},
+		{Tag: "v2", UpdatedAt: now.Add(-48 * time.Hour)},
+		{Tag: "latest", UpdatedAt: now},
+	}
+
+	cases := []struct {
+		keep     int
+		expected []string
+	}{
+		{keep: 1, expected: []string{"v3", "v2", "v1"}},
+		{keep: 3, expected: []string{"v1"}},
+		{keep: 4, expected: nil},
+		{keep: 10, expected: nil},
+	}
+
+	for _, tc := range cases {
+		var pruned []string
+		for _, tag := range containerRegistryTagsToPrune(tags, tc.keep) {
+			pruned = append(pruned, tag.Tag)
+		}
+
+		if !reflect.DeepEqual(pruned, tc.expected) {
+			t.Fatalf("keep %d: expected %v, got %v", tc.keep, tc.expected, pruned)
+		}
+	}
+
+	if tags[0].Tag != "v1" {
+		t.Fatalf("expected the tags not to be reordered, got %s first", tags[0].Tag)
+	}
+}
+
+func TestContainerRegistryManifestsToPrune(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	manifests := []*containerRegistryManifest{
+		{Digest: "sha256:old-untagged", UpdatedAt: now.Add(-10 * 24 * time.Hour)},
+		{Digest: "sha256:old-tagged", UpdatedAt: now.Add(-10 * 24 * time.Hour), Tags: []string{"v1"}},
+		{Digest: "sha256:new-untagged", UpdatedAt: now.Add(-1 * 24 * time.Hour)},
+	}
+
+	var pruned []string
+	for _, manifest := range containerRegistryManifestsToPrune(manifests, 7*24*time.Hour, now) {
+		pruned = append(pruned, manifest.Digest)
+	}
+
+	expected := []string{"sha256:old-untagged"}
+	if !reflect.DeepEqual(pruned, expected) {
+		t.Fatalf("expected %v, got %v", expected, pruned)
+	}
+}
+
+// The policy deletes tags and manifests of the repository, it must only hold
+// images pushed for the test.
+func TestAccDigitalOceanContainerRegistryRetentionPolicy_Basic(t *testing.T) {
+	registryName := os.Getenv("DIGITALOCEAN_REGISTRY_NAME")
+	repository := os.Getenv("DIGITALOCEAN_REGISTRY_DISPOSABLE_REPOSITORY")
+	if registryName == "" || repository == "" {
+		t.Skip("DIGITALOCEAN_REGISTRY_NAME and DIGITALOCEAN_REGISTRY_DISPOSABLE_REPOSITORY must be set to test retention policies")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanContainerRegistryRetentionPolicyConfig(registryName, repository),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry_retention_policy.foobar", "id", registryName),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registry_retention_policy.foobar", "pruned_tag_count"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registry_retention_policy.foobar", "pruned_manifest_count"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registry_retention_policy.foobar", "applied_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanContainerRegistryRetentionPolicyConfig(registryName, repository string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_container_registry_retention_policy" "foobar" {
+  registry_name                  = "%s"
+  repositories                   = ["%s"]
+  keep_last_tags                 = 1
+  untagged_manifest_max_age_days = 1
+}`, registryName, repository)
+}
@@ -3,6 +3,7 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -34,6 +35,8 @@ func TestAccDigitalOceanContainerRegistry_Basic(t *testing.T) {
 						"digitalocean_container_registry.foobar", "server_url", "registry.digitalocean.com"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_container_registry.foobar", "subscription_tier_slug", "starter"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registry.foobar", "region"),
 				),
 			},
 			{
@@ -49,12 +52,45 @@ func TestAccDigitalOceanContainerRegistry_Basic(t *testing.T) {
 						"digitalocean_container_registry.foobar", "server_url", "registry.digitalocean.com"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_container_registry.foobar", "subscription_tier_slug", "basic"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry.foobar", "storage_usage_bytes", "0"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registry.foobar", "included_storage_bytes"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registry.foobar", "included_bandwidth_bytes"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccDigitalOceanContainerRegistry_Region(t *testing.T) {
+	var reg godo.Registry
+	name := randomTestName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanContainerRegistryConfig_region, name, "SFO3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanContainerRegistryExists("digitalocean_container_registry.foobar", &reg),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry.foobar", "name", name),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry.foobar", "region", "sfo3"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanContainerRegistryConfig_region, name, "ams3"),
+				ExpectError: regexp.MustCompile("can not be changed from sfo3 to ams3"),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanContainerRegistryDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
 
@@ -117,3 +153,10 @@ resource "digitalocean_container_registry" "foobar" {
   name                   = "%s"
   subscription_tier_slug = "%s"
 }`
+
+var testAccCheckDigitalOceanContainerRegistryConfig_region = `
+resource "digitalocean_container_registry" "foobar" {
+  name                   = "%s"
+  subscription_tier_slug = "starter"
+  region                 = "%s"
+}`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +29,9 @@ func resourceDigitalOceanCustomImage() *schema.Resource {
 		CreateContext: resourceDigitalOceanCustomImageCreate,
 		UpdateContext: resourceDigitalOceanCustomImageUpdate,
 		DeleteContext: resourceDigitalOceanCustomImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -36,9 +40,59 @@ func resourceDigitalOceanCustomImage() *schema.Resource {
 			},
 			"url": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"url", "source"},
+				// The API does not return the URL an image was imported
+				// from, it is unknown for imported images.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"url", "source"},
+				// The path of the local file an image was uploaded from is
+				// unknown for imported images.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+			},
+			// The bucket and region the local file is uploaded to are only
+			// used when creating the image, changing them afterwards has no
+			// effect.
+			"staging_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				RequiredWith: []string{"source"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"staging_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(SpacesRegions, true),
+				RequiredWith: []string{"source"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"checksum": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateImageChecksum,
+				// The checksum is only verified when importing the image,
+				// it is unknown for imported images.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
 			},
 			"regions": {
 				Type:     schema.TypeSet,
@@ -92,6 +146,10 @@ func resourceDigitalOceanCustomImage() *schema.Resource {
 				Computed: true,
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
 		// Images can not currently be removed from a region.
 		CustomizeDiff: customdiff.ForceNewIfChange("regions", func(ctx context.Context, old, new, meta interface{}) bool {
 			remove, _ := getSetChanges(old.(*schema.Set), new.(*schema.Set))
@@ -100,16 +158,53 @@ func resourceDigitalOceanCustomImage() *schema.Resource {
 	}
 }
 
-func resourceDigitalOceanCustomImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDigitalOceanCustomImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	client := meta.(*CombinedConfig).godoClient()
 
 	// We import the image to the first region. We can distribute it to others once it is available.
 	regions := d.Get("regions").(*schema.Set).List()
 	region := regions[0].(string)
 
+	url := d.Get("url").(string)
+	checksum := d.Get("checksum").(string)
+
+	if checksum != "" && url != "" {
+		log.Printf("[INFO] Verifying the checksum of %s", url)
+		if err := verifyImageChecksum(ctx, meta, url, checksum); err != nil {
+			return diag.Errorf("Error verifying the checksum of %s: %s", url, err)
+		}
+	}
+
+	// A local image is uploaded to Spaces for the API to import it from
+	// there, it is deleted once the image is imported.
+	if source, ok := d.GetOk("source"); ok {
+		stagingRegion := d.Get("staging_region").(string)
+		if stagingRegion == "" && stringInSlice(region, SpacesRegions) {
+			stagingRegion = region
+		}
+
+		staged, err := stageImage(ctx, meta, source.(string), checksum, d.Get("staging_bucket").(string), stagingRegion, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("Error uploading custom image %s to Spaces: %s", source, err)
+		}
+		// The staged image is deleted whether the import succeeded or not,
+		// a failure to delete it is reported as it is billed until then.
+		defer func() {
+			if err := staged.cleanup(); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Error deleting custom image %s uploaded to Spaces", source),
+					Detail:   fmt.Sprintf("%s. Delete it from Spaces to stop being billed for it.", err),
+				})
+			}
+		}()
+
+		url = staged.url
+	}
+
 	imageCreateRequest := godo.CustomImageCreateRequest{
 		Name:   d.Get("name").(string),
-		Url:    d.Get("url").(string),
+		Url:    url,
 		Region: region,
 	}
 
@@ -133,9 +228,13 @@ func resourceDigitalOceanCustomImageCreate(ctx context.Context, d *schema.Resour
 	id := strconv.Itoa(imageResponse.ID)
 	d.SetId(id)
 
-	_, err = waitForImage(ctx, d, imageAvailableStatus, imagePendingStatuses(), "status", meta)
+	image, err := waitForImage(ctx, d, imageAvailableStatus, imagePendingStatuses(), "status", d.Timeout(schema.TimeoutCreate), meta)
 	if err != nil {
-		return diag.Errorf("Error waiting for image (%s) to become ready: %s", d.Id(), err)
+		// The images failing to be imported are deleted by the API.
+		if image, ok := image.(*godo.Image); ok && image.Status == imageDeletedStatus {
+			d.SetId("")
+		}
+		return diag.Errorf("Error waiting for image (%s) to become ready: %s", id, err)
 	}
 
 	if len(regions) > 1 {
@@ -144,7 +243,7 @@ func resourceDigitalOceanCustomImageCreate(ctx context.Context, d *schema.Resour
 		regions[len(regions)-1] = ""
 		regions = regions[:len(regions)-1]
 		log.Printf("[INFO] Image available in: %s Distributing to: %v", region, regions)
-		err = distributeImageToRegions(client, imageResponse.ID, regions)
+		err = distributeImageToRegions(ctx, meta, imageResponse.ID, regions, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.Errorf("Error distributing image (%s) to additional regions: %s", d.Id(), err)
 		}
@@ -217,7 +316,7 @@ func resourceDigitalOceanCustomImageUpdate(ctx context.Context, d *schema.Resour
 	if d.HasChange("regions") {
 		old, new := d.GetChange("regions")
 		_, add := getSetChanges(old.(*schema.Set), new.(*schema.Set))
-		err = distributeImageToRegions(client, id, add.List())
+		err = distributeImageToRegions(ctx, meta, id, add.List(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.Errorf("Error distributing image (%s) to additional regions: %s", d.Id(), err)
 		}
@@ -247,18 +346,9 @@ func resourceDigitalOceanCustomImageDelete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
-func waitForImage(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) {
+func waitForImage(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, timeout time.Duration, meta interface{}) (interface{}, error) {
 	log.Printf("[INFO] Waiting for image (%s) to have %s of %s", d.Id(), attribute, target)
-	stateConf := &resource.StateChangeConf{
-		Pending:    pending,
-		Target:     []string{target},
-		Refresh:    imageStateRefreshFunc(ctx, d, attribute, meta),
-		Timeout:    120 * time.Minute,
-		Delay:      1 * time.Second,
-		MinTimeout: 60 * time.Second,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	return meta.(*CombinedConfig).poller().waitForState(ctx, timeout, pending, []string{target}, imageStateRefreshFunc(ctx, d, attribute, meta))
 }
 
 func imageStateRefreshFunc(ctx context.Context, d *schema.ResourceData, state string, meta interface{}) resource.StateRefreshFunc {
@@ -272,20 +362,25 @@ func imageStateRefreshFunc(ctx context.Context, d *schema.ResourceData, state st
 			return nil, "", err
 		}
 
-		imageResponse, _, err := client.Images.GetByID(ctx, id)
+		imageResponse, resp, err := client.Images.GetByID(ctx, id)
 		if err != nil {
+			// The image can take a moment to be visible after being
+			// created.
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, "", nil
+			}
 			return nil, "", err
 		}
 
 		if imageResponse.Status == imageDeletedStatus {
-			return nil, "", fmt.Errorf(imageResponse.ErrorMessage)
+			return imageResponse, imageResponse.Status, imageImportError(imageResponse)
 		}
 
 		return imageResponse, imageResponse.Status, nil
 	}
 }
 
-func distributeImageToRegions(client *godo.Client, imageId int, regions []interface{}) (err error) {
+func distributeImageToRegions(ctx context.Context, meta interface{}, imageId int, regions []interface{}, timeout time.Duration) (err error) {
 	for _, region := range regions {
 		transferRequest := &godo.ActionRequest{
 			"type":   "transfer",
@@ -293,12 +388,12 @@ func distributeImageToRegions(client *godo.Client, imageId int, regions []interf
 		}
 
 		log.Printf("[INFO] Transferring image (%d) to: %s", imageId, region)
-		action, _, err := client.ImageActions.Transfer(context.TODO(), imageId, transferRequest)
+		action, _, err := meta.(*CombinedConfig).godoClient().ImageActions.Transfer(ctx, imageId, transferRequest)
 		if err != nil {
 			return err
 		}
 
-		err = waitForAction(client, action)
+		err = waitForActionWithTimeout(ctx, meta, action, timeout)
 		if err != nil {
 			return err
 		}
@@ -3,13 +3,62 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("digitalocean_custom_image", &resource.Sweeper{
+		Name: "digitalocean_custom_image",
+		F:    testSweepCustomImages,
+	})
+}
+
+func testSweepCustomImages(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*CombinedConfig).godoClient()
+
+	opt := &godo.ListOptions{PerPage: 200}
+	images, _, err := client.Images.ListUser(context.Background(), opt)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range images {
+		if strings.HasPrefix(i.Name, testNamePrefix) {
+			log.Printf("Destroying custom image %s", i.Name)
+
+			if _, err := client.Images.Delete(context.Background(), i.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func TestAccDigitalOceanCustomImageFull(t *testing.T) {
 	rString := randomTestName()
 	name := fmt.Sprintf("digitalocean_custom_image.%s", rString)
@@ -91,6 +140,234 @@ func TestAccDigitalOceanCustomImageMultiRegion(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanCustomImage_ChecksumMismatch(t *testing.T) {
+	rString := randomTestName()
+	checksum := "sha256:" + strings.Repeat("0", 64)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCustomImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDigitalOceanCustomImageConfig_checksum(rString, checksum),
+				ExpectError: regexp.MustCompile("the image has checksum sha256:[0-9a-f]{64}, expected " + checksum),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanCustomImage_SourceImportFailure(t *testing.T) {
+	rString := randomTestName()
+
+	// Random bytes are uploaded to Spaces, but are not an image the API can
+	// import.
+	source := filepath.Join(t.TempDir(), "image.img")
+	if err := ioutil.WriteFile(source, []byte(acctest.RandString(1024)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanCustomImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_custom_image" "%s" {
+  name    = "%s-name"
+  source  = "%s"
+  regions = ["nyc3"]
+}
+`, rString, rString, source),
+				ExpectError: regexp.MustCompile(`import of image \(\d+\) failed`),
+			},
+		},
+	})
+}
+
+func TestStagedImageUpload(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		objects = map[string]string{}
+		parts   = map[string][]string{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body, _ := ioutil.ReadAll(r.Body)
+		query := r.URL.Query()
+		switch {
+		case r.Method == http.MethodPut && query.Get("partNumber") != "":
+			parts[r.URL.Path] = append(parts[r.URL.Path], string(body))
+			w.Header().Set("ETag", fmt.Sprintf(`"part-%s"`, query.Get("partNumber")))
+		case r.Method == http.MethodPut:
+			objects[r.URL.Path] = string(body)
+		case r.Method == http.MethodPost && query["uploads"] != nil:
+			fmt.Fprint(w, `<InitiateMultipartUploadResult><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`)
+		case r.Method == http.MethodPost && query.Get("uploadId") == "upload-1":
+			if !strings.Contains(string(body), "part-3") {
+				t.Errorf("expected the parts to be listed when completing the upload, got %s", body)
+			}
+			objects[r.URL.Path] = strings.Join(parts[r.URL.Path], "")
+			fmt.Fprint(w, `<CompleteMultipartUploadResult></CompleteMultipartUploadResult>`)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:           aws.String("us-east-1"),
+		Credentials:      credentials.NewStaticCredentials("access", "secret", ""),
+		Endpoint:         aws.String(server.URL),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	staged := &stagedImage{svc: s3.New(sess), bucket: "images"}
+
+	partSize := imageStagingPartSize
+	imageStagingPartSize = 4
+	defer func() { imageStagingPartSize = partSize }()
+
+	for _, content := range []string{"tiny", "foobarbaz"} {
+		staged.key = content + ".img"
+		if err := staged.upload(context.Background(), strings.NewReader(content), int64(len(content))); err != nil {
+			t.Fatalf("unexpected error uploading %q: %s", content, err)
+		}
+		if actual := objects["/images/"+staged.key]; actual != content {
+			t.Errorf("expected %q to be uploaded, got %q", content, actual)
+		}
+	}
+
+	if n := len(parts["/images/foobarbaz.img"]); n != 3 {
+		t.Errorf("expected an image larger than the part size to be uploaded in 3 parts, got %d", n)
+	}
+	if n := len(parts["/images/tiny.img"]); n != 0 {
+		t.Errorf("expected an image as large as the part size to be uploaded at once, got %d parts", n)
+	}
+}
+
+func TestStagedImageCleanup(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		if r.URL.Path == "/tf-custom-image-full" {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `<Error><Code>BucketNotEmpty</Code></Error>`)
+			return
+		}
+		deleted = append(deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:           aws.String("us-east-1"),
+		Credentials:      credentials.NewStaticCredentials("access", "secret", ""),
+		Endpoint:         aws.String(server.URL),
+		S3ForcePathStyle: aws.Bool(true),
+		MaxRetries:       aws.Int(0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	staged := &stagedImage{svc: s3.New(sess), bucket: "tf-custom-image-empty", key: "image.img", createdBucket: true}
+	if err := staged.cleanup(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if expected := []string{"/tf-custom-image-empty/image.img", "/tf-custom-image-empty"}; !reflect.DeepEqual(deleted, expected) {
+		t.Errorf("expected %v to be deleted, got %v", expected, deleted)
+	}
+
+	staged = &stagedImage{svc: s3.New(sess), bucket: "tf-custom-image-full", key: "image.img", createdBucket: true}
+	if err := staged.cleanup(); err == nil || !strings.Contains(err.Error(), "error deleting temporary Spaces bucket tf-custom-image-full") {
+		t.Errorf("expected an error deleting the bucket, got %v", err)
+	}
+}
+
+func TestParseImageChecksum(t *testing.T) {
+	cases := []struct {
+		checksum  string
+		algorithm string
+		digest    string
+		err       string
+	}{
+		{checksum: "sha256:" + strings.Repeat("A", 64), algorithm: "sha256", digest: strings.Repeat("a", 64)},
+		{checksum: "MD5:" + strings.Repeat("0", 32), algorithm: "md5", digest: strings.Repeat("0", 32)},
+		{checksum: strings.Repeat("0", 64), err: "must be of the form"},
+		{checksum: "crc32:00000000", err: "must be one of md5, sha1, sha256, sha512"},
+		{checksum: "sha1:" + strings.Repeat("0", 64), err: "must be 40 hexadecimal characters"},
+		{checksum: "sha1:" + strings.Repeat("z", 40), err: "must be 40 hexadecimal characters"},
+	}
+
+	for _, c := range cases {
+		algorithm, digest, err := parseImageChecksum(c.checksum)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("expected an error containing %q for %q, got %v", c.err, c.checksum, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", c.checksum, err)
+			continue
+		}
+		if algorithm != c.algorithm || digest != c.digest {
+			t.Errorf("expected %q to be parsed as %s:%s, got %s:%s", c.checksum, c.algorithm, c.digest, algorithm, digest)
+		}
+	}
+}
+
+func TestVerifyImageChecksum(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/image.bin" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, "foobar")
+	}))
+	defer server.Close()
+
+	meta := &CombinedConfig{}
+	ctx := context.Background()
+
+	// sha256 of "foobar".
+	checksum := "sha256:c3ab8ff13720e8ad9047dd39466b3c8974e592c2fa383d4a3960714caef0c4f2"
+	if err := verifyImageChecksum(ctx, meta, server.URL+"/image.bin", checksum); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	err := verifyImageChecksum(ctx, meta, server.URL+"/image.bin", "sha256:"+strings.Repeat("0", 64))
+	if err == nil || !strings.Contains(err.Error(), "the image has checksum "+checksum) {
+		t.Errorf("expected a checksum mismatch error, got %v", err)
+	}
+
+	err = verifyImageChecksum(ctx, meta, server.URL+"/missing.bin", checksum)
+	if err == nil || !strings.Contains(err.Error(), "404 Not Found") {
+		t.Errorf("expected an error for a missing image, got %v", err)
+	}
+}
+
+func TestImageImportError(t *testing.T) {
+	err := imageImportError(&godo.Image{ID: 42, ErrorMessage: "unsupported image format"})
+	if expected := "import of image (42) failed: unsupported image format"; err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err)
+	}
+
+	err = imageImportError(&godo.Image{ID: 42})
+	if !strings.Contains(err.Error(), "check that its URL is publicly reachable") {
+		t.Errorf("expected a hint when the API gives no reason, got %q", err)
+	}
+}
+
 func testAccCheckDigitalOceanCustomImageConfig(rName string, name string, regions string, distro string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_custom_image" "%s" {
@@ -132,3 +409,14 @@ func testAccCheckDigitalOceanCustomImageDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckDigitalOceanCustomImageConfig_checksum(name string, checksum string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_custom_image" "%s" {
+	name     = "%s-name"
+	url      = "https://stable.release.flatcar-linux.net/amd64-usr/2605.7.0/flatcar_production_digitalocean_image.bin.bz2"
+	checksum = "%s"
+	regions  = ["nyc3"]
+}
+`, name, name, checksum)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/digitalocean/godo"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,9 +17,10 @@ import (
 )
 
 const (
-	mongoDBEngineSlug = "mongodb"
-	mysqlDBEngineSlug = "mysql"
-	redisDBEngineSlug = "redis"
+	mongoDBEngineSlug  = "mongodb"
+	mysqlDBEngineSlug  = "mysql"
+	redisDBEngineSlug  = "redis"
+	valkeyDBEngineSlug = "valkey"
 )
 
 func resourceDigitalOceanDatabaseCluster() *schema.Resource {
@@ -28,7 +30,9 @@ func resourceDigitalOceanDatabaseCluster() *schema.Resource {
 		UpdateContext: resourceDigitalOceanDatabaseClusterUpdate,
 		DeleteContext: resourceDigitalOceanDatabaseClusterDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithProject(func(id string) string {
+				return godo.Database{ID: id}.URN()
+			}),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,6 +48,11 @@ func resourceDigitalOceanDatabaseCluster() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
+				// Redis clusters are being migrated to Valkey in place. Prevent
+				// attempting to recreate clusters still specifying redis in their config.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == valkeyDBEngineSlug && new == redisDBEngineSlug
+				},
 			},
 
 			"version": {
@@ -53,14 +62,27 @@ func resourceDigitalOceanDatabaseCluster() *schema.Resource {
 				// CustomizeDiffFunc is used to provide users with a better hint in the error message.
 				// Required: true,
 				Optional: true,
-				ForceNew: true,
 				// Redis clusters are being force upgraded from version 5 to 6.
 				// Prevent attempting to recreate clusters specifying 5 in their config.
+				// Clusters migrated to Valkey also report the Valkey version, so
+				// ignore the Redis version of the ones still specifying redis.
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldEngine, newEngine := d.GetChange("engine")
+					if oldEngine == valkeyDBEngineSlug && newEngine == redisDBEngineSlug {
+						return true
+					}
+
 					return d.Get("engine") == redisDBEngineSlug && old == "6" && new == "5"
 				},
 			},
 
+			"allow_major_version_upgrade": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "confirms that changing the major version upgrades the cluster in place",
+			},
+
 			"size": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -121,6 +143,38 @@ func resourceDigitalOceanDatabaseCluster() *schema.Resource {
 				Optional: true,
 			},
 
+			"storage_autoscaling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"threshold_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(50, 95),
+						},
+						"increment_gib": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(10),
+						},
+						"max_size_gib": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
 			"private_network_uuid": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -178,15 +232,20 @@ func resourceDigitalOceanDatabaseCluster() *schema.Resource {
 			},
 
 			"tags": tagsSchema(),
+
+			"project_id": projectIDSchema(),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
 			transitionVersionToRequired(),
 			validateExclusiveAttributes(),
+			validateValkeyVersion(),
+			validateVersionUpgrade(),
 		),
 	}
 }
@@ -219,14 +278,100 @@ func validateExclusiveAttributes() schema.CustomizeDiffFunc {
 			return fmt.Errorf("sql_mode is only supported for MySQL Database Clusters")
 		}
 
-		if hasEvictionPolicy && engine != redisDBEngineSlug {
-			return fmt.Errorf("eviction_policy is only supported for Redis Database Clusters")
+		if hasEvictionPolicy && engine != redisDBEngineSlug && engine != valkeyDBEngineSlug {
+			return fmt.Errorf("eviction_policy is only supported for Redis and Valkey Database Clusters")
 		}
 
 		return nil
 	})
 }
 
+// validateValkeyVersion ensures Valkey clusters request a supported version.
+// Valkey was forked from Redis 7.2, so no earlier versions exist.
+func validateValkeyVersion() schema.CustomizeDiffFunc {
+	return schema.CustomizeDiffFunc(func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+		engine := diff.Get("engine").(string)
+		ver := diff.Get("version").(string)
+		if engine != valkeyDBEngineSlug || ver == "" {
+			return nil
+		}
+
+		valkeyVer, err := version.NewVersion(ver)
+		if err != nil {
+			return fmt.Errorf("Unable to parse Valkey version %q: %s", ver, err)
+		}
+
+		if valkeyVer.LessThan(version.Must(version.NewVersion("7"))) {
+			return fmt.Errorf("Valkey Database Clusters require version 7 or later, got: %s", ver)
+		}
+
+		return nil
+	})
+}
+
+// validateVersionUpgrade ensures that a change to the version of an existing
+// cluster can be performed in place by the upgrade API. Downgrades are never
+// supported and the target version must be offered for the engine.
+func validateVersionUpgrade() schema.CustomizeDiffFunc {
+	return customdiff.IfValueChange("version",
+		func(ctx context.Context, old, new, meta interface{}) bool {
+			return old.(string) != "" && new.(string) != "" && old.(string) != new.(string)
+		},
+		func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			engine := diff.Get("engine").(string)
+			o, n := diff.GetChange("version")
+			oldVersion, newVersion := o.(string), n.(string)
+
+			if engine == redisDBEngineSlug && oldVersion == "6" && newVersion == "5" {
+				return nil
+			}
+
+			if oldEngine, _ := diff.GetChange("engine"); oldEngine == valkeyDBEngineSlug && engine == redisDBEngineSlug {
+				return nil
+			}
+
+			oldVer, err := version.NewVersion(oldVersion)
+			if err != nil {
+				return fmt.Errorf("Unable to parse database cluster version %q: %s", oldVersion, err)
+			}
+			newVer, err := version.NewVersion(newVersion)
+			if err != nil {
+				return fmt.Errorf("Unable to parse database cluster version %q: %s", newVersion, err)
+			}
+
+			if newVer.LessThan(oldVer) {
+				return fmt.Errorf("Downgrading %s database clusters from version %s to %s is not supported", engine, oldVersion, newVersion)
+			}
+
+			// Major version upgrades cannot be rolled back, so they have to be
+			// confirmed rather than done by any change of version.
+			if newVer.Segments()[0] != oldVer.Segments()[0] && !diff.Get("allow_major_version_upgrade").(bool) {
+				return fmt.Errorf("Upgrading %s database clusters from version %s to %s is a major version upgrade, set allow_major_version_upgrade to confirm it", engine, oldVersion, newVersion)
+			}
+
+			client := meta.(*CombinedConfig).godoClient()
+			options, _, err := getDatabaseOptions(context.Background(), client)
+			if err != nil {
+				return fmt.Errorf("Error retrieving database options: %s", err)
+			}
+
+			engineOptions, ok := options[engine]
+			if !ok {
+				return fmt.Errorf("In-place version upgrades are not supported for %s database clusters", engine)
+			}
+
+			for _, v := range engineOptions.Versions {
+				if v == newVersion {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("Version %s is not available for %s database clusters, valid versions are: %s",
+				newVersion, engine, strings.Join(engineOptions.Versions, ", "))
+		},
+	)
+}
+
 func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*CombinedConfig).godoClient()
 
@@ -262,7 +407,7 @@ func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.Re
 	d.SetId(database.ID)
 	log.Printf("[INFO] database cluster Name: %s", database.Name)
 
-	database, err = waitForDatabaseCluster(client, d, "online")
+	database, err = waitForDatabaseCluster(client, d, "online", d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		d.SetId("")
 		return diag.Errorf("Error creating database cluster: %s", err)
@@ -298,6 +443,19 @@ func resourceDigitalOceanDatabaseClusterCreate(ctx context.Context, d *schema.Re
 		}
 	}
 
+	if v, ok := d.GetOk("storage_autoscaling"); ok {
+		_, err := updateDatabaseAutoscale(context.Background(), client, d.Id(), expandStorageAutoscaling(v.([]interface{})))
+		if err != nil {
+			return diag.Errorf("Error configuring storage autoscaling for database cluster: %s", err)
+		}
+	}
+
+	if _, ok := d.GetOk("project_id"); ok {
+		if err := assignToProject(client, d, database.URN()); err != nil {
+			return diag.Errorf("Error assigning database cluster to project: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanDatabaseClusterRead(ctx, d, meta)
 }
 
@@ -322,7 +480,7 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 			return diag.Errorf("Error resizing database cluster: %s", err)
 		}
 
-		_, err = waitForDatabaseCluster(client, d, "online")
+		_, err = waitForDatabaseCluster(client, d, "online", d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.Errorf("Error resizing database cluster: %s", err)
 		}
@@ -345,12 +503,34 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 			return diag.Errorf("Error migrating database cluster: %s", err)
 		}
 
-		_, err = waitForDatabaseCluster(client, d, "online")
+		_, err = waitForDatabaseCluster(client, d, "online", d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.Errorf("Error migrating database cluster: %s", err)
 		}
 	}
 
+	if d.HasChange("version") {
+		newVersion := d.Get("version").(string)
+
+		log.Printf("[INFO] Upgrading database cluster %s to version %s", d.Id(), newVersion)
+		resp, err := upgradeDatabaseMajorVersion(context.Background(), client, d.Id(), newVersion)
+		if err != nil {
+			// If the database is somehow already destroyed, mark as
+			// successfully gone
+			if resp != nil && resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+
+			return diag.Errorf("Error upgrading database cluster: %s", err)
+		}
+
+		err = waitForDatabaseClusterVersion(client, d, newVersion)
+		if err != nil {
+			return diag.Errorf("Error upgrading database cluster: %s", err)
+		}
+	}
+
 	if d.HasChange("maintenance_window") {
 		opts := expandMaintWindowOpts(d.Get("maintenance_window").([]interface{}))
 
@@ -389,6 +569,13 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 		}
 	}
 
+	if d.HasChange("storage_autoscaling") {
+		_, err := updateDatabaseAutoscale(context.Background(), client, d.Id(), expandStorageAutoscaling(d.Get("storage_autoscaling").([]interface{})))
+		if err != nil {
+			return diag.Errorf("Error updating storage autoscaling for database cluster: %s", err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		err := setTags(client, d, godo.DatabaseResourceType)
 		if err != nil {
@@ -396,6 +583,12 @@ func resourceDigitalOceanDatabaseClusterUpdate(ctx context.Context, d *schema.Re
 		}
 	}
 
+	if d.HasChange("project_id") {
+		if err := assignToProject(client, d, godo.Database{ID: d.Id()}.URN()); err != nil {
+			return diag.Errorf("Error assigning database cluster to project: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanDatabaseClusterRead(ctx, d, meta)
 }
 
@@ -420,7 +613,10 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 	d.Set("size", database.SizeSlug)
 	d.Set("region", database.RegionSlug)
 	d.Set("node_count", database.NumNodes)
-	d.Set("tags", flattenTags(database.Tags))
+
+	if err := d.Set("tags", flattenTags(database.Tags)); err != nil {
+		return diag.Errorf("Error setting `tags`: %+v", err)
+	}
 
 	if _, ok := d.GetOk("maintenance_window"); ok {
 		if err := d.Set("maintenance_window", flattenMaintWindowOpts(*database.MaintenanceWindow)); err != nil {
@@ -446,6 +642,17 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 		d.Set("sql_mode", mode)
 	}
 
+	if _, ok := d.GetOk("storage_autoscaling"); ok {
+		autoscale, _, err := getDatabaseAutoscale(context.Background(), client, d.Id())
+		if err != nil {
+			return diag.Errorf("Error retrieving storage autoscaling for database cluster: %s", err)
+		}
+
+		if err := d.Set("storage_autoscaling", flattenStorageAutoscaling(autoscale)); err != nil {
+			return diag.Errorf("[DEBUG] Error setting storage_autoscaling - error: %#v", err)
+		}
+	}
+
 	// Computed values
 	err = setDatabaseConnectionInfo(database, d)
 	if err != nil {
@@ -454,6 +661,10 @@ func resourceDigitalOceanDatabaseClusterRead(ctx context.Context, d *schema.Reso
 	d.Set("urn", database.URN())
 	d.Set("private_network_uuid", database.PrivateNetworkUUID)
 
+	if err := readProjectAssignment(meta, d, database.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -470,10 +681,10 @@ func resourceDigitalOceanDatabaseClusterDelete(ctx context.Context, d *schema.Re
 	return nil
 }
 
-func waitForDatabaseCluster(client *godo.Client, d *schema.ResourceData, status string) (*godo.Database, error) {
+func waitForDatabaseCluster(client *godo.Client, d *schema.ResourceData, status string, duration time.Duration) (*godo.Database, error) {
 	var (
 		tickerInterval = 15 * time.Second
-		timeoutSeconds = d.Timeout(schema.TimeoutDelete).Seconds()
+		timeoutSeconds = duration.Seconds()
 		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
 		n              = 0
 		ticker         = time.NewTicker(tickerInterval)
@@ -502,6 +713,40 @@ func waitForDatabaseCluster(client *godo.Client, d *schema.ResourceData, status
 	return nil, fmt.Errorf("Timeout waiting to database cluster to become %s", status)
 }
 
+// waitForDatabaseClusterVersion waits for an in-place upgrade to complete,
+// that is for the cluster to report the new version and be back online.
+func waitForDatabaseClusterVersion(client *godo.Client, d *schema.ResourceData, targetVersion string) error {
+	var (
+		tickerInterval = 15 * time.Second
+		timeoutSeconds = d.Timeout(schema.TimeoutUpdate).Seconds()
+		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
+		n              = 0
+		ticker         = time.NewTicker(tickerInterval)
+	)
+
+	for range ticker.C {
+		database, _, err := client.Databases.Get(context.Background(), d.Id())
+		if err != nil {
+			ticker.Stop()
+			return fmt.Errorf("Error trying to read database cluster state: %s", err)
+		}
+
+		if database.VersionSlug == targetVersion && database.Status == "online" {
+			ticker.Stop()
+			return nil
+		}
+
+		if n > timeout {
+			ticker.Stop()
+			break
+		}
+
+		n++
+	}
+
+	return fmt.Errorf("Timeout waiting for database cluster to be upgraded to version %s", targetVersion)
+}
+
 func expandMaintWindowOpts(config []interface{}) *godo.DatabaseUpdateMaintenanceRequest {
 	maintWindowOpts := &godo.DatabaseUpdateMaintenanceRequest{}
 	configMap := config[0].(map[string]interface{})
@@ -528,6 +773,38 @@ func flattenMaintWindowOpts(opts godo.DatabaseMaintenanceWindow) []map[string]in
 	return result
 }
 
+// expandStorageAutoscaling builds the autoscaling configuration from the
+// storage_autoscaling block. Removing the block disables autoscaling.
+func expandStorageAutoscaling(config []interface{}) *databaseAutoscale {
+	storage := &databaseStorageAutoscale{}
+
+	if len(config) > 0 && config[0] != nil {
+		configMap := config[0].(map[string]interface{})
+		storage.Enabled = configMap["enabled"].(bool)
+		storage.ThresholdPercent = configMap["threshold_percent"].(int)
+		storage.IncrementGiB = configMap["increment_gib"].(int)
+		storage.MaxSizeGiB = configMap["max_size_gib"].(int)
+	}
+
+	return &databaseAutoscale{Storage: storage}
+}
+
+func flattenStorageAutoscaling(autoscale *databaseAutoscale) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if autoscale == nil || autoscale.Storage == nil {
+		return result
+	}
+
+	item := make(map[string]interface{})
+	item["enabled"] = autoscale.Storage.Enabled
+	item["threshold_percent"] = autoscale.Storage.ThresholdPercent
+	item["increment_gib"] = autoscale.Storage.IncrementGiB
+	item["max_size_gib"] = autoscale.Storage.MaxSizeGiB
+	result = append(result, item)
+
+	return result
+}
+
 func setDatabaseConnectionInfo(database *godo.Database, d *schema.ResourceData) error {
 	if database.Connection != nil {
 		d.Set("host", database.Connection.Host)
@@ -128,6 +128,71 @@ func TestAccDigitalOceanDatabaseCluster_WithUpdate(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_WithVersionUpgrade(t *testing.T) {
+	var database godo.Database
+	databaseName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithVersion, databaseName, "12", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "version", "12"),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithVersion, databaseName, "13", false),
+				ExpectError: regexp.MustCompile(`set allow_major_version_upgrade to confirm it`),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithVersion, databaseName, "13", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "version", "13"),
+					resource.TestCheckResourceAttrPtr(
+						"digitalocean_database_cluster.foobar", "id", &database.ID),
+				),
+			},
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithVersion, databaseName, "12", false),
+				ExpectError: regexp.MustCompile(`Downgrading pg database clusters from version 13 to 12 is not supported`),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDatabaseCluster_WithProject(t *testing.T) {
+	var database godo.Database
+	databaseName := randomTestName()
+	projectName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithProject, projectName, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_database_cluster.foobar", "project_id", "digitalocean_project.foobar", "id"),
+					testAccCheckDigitalOceanDatabaseClusterInProject("digitalocean_project.foobar", &database),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseCluster_WithMigration(t *testing.T) {
 	var database godo.Database
 	databaseName := randomTestName()
@@ -183,6 +248,40 @@ func TestAccDigitalOceanDatabaseCluster_WithMaintWindow(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_WithStorageAutoscaling(t *testing.T) {
+	var database godo.Database
+	databaseName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithStorageAutoscaling, databaseName, "true", 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "storage_autoscaling.0.enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "storage_autoscaling.0.max_size_gib", "200"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_cluster.foobar", "storage_autoscaling.0.threshold_percent"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigWithStorageAutoscaling, databaseName, "false", 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "storage_autoscaling.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseCluster_WithSQLMode(t *testing.T) {
 	var database godo.Database
 	databaseName := randomTestName()
@@ -348,6 +447,134 @@ func TestAccDigitalOceanDatabaseCluster_CheckEvictionPolicySupport(t *testing.T)
 	})
 }
 
+func TestAccDigitalOceanDatabaseCluster_ValkeyWithEvictionPolicy(t *testing.T) {
+	var database godo.Database
+	databaseName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigValkey, databaseName, "8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "engine", "valkey"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "eviction_policy", "allkeys_lru"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDatabaseCluster_CheckValkeyVersion(t *testing.T) {
+	databaseName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigValkey, databaseName, "6"),
+				ExpectError: regexp.MustCompile(`Valkey Database Clusters require version 7 or later`),
+			},
+		},
+	})
+}
+
+func TestResourceDigitalOceanDatabaseClusterValkeyMigrationDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "cluster-1",
+		Attributes: map[string]string{
+			"id":         "cluster-1",
+			"name":       "cluster-1",
+			"engine":     "valkey",
+			"version":    "8",
+			"size":       "db-s-1vcpu-1gb",
+			"region":     "nyc1",
+			"node_count": "1",
+		},
+	}
+
+	cases := []struct {
+		name   string
+		engine string
+		err    string
+	}{
+		{
+			name:   "migrated from redis",
+			engine: "redis",
+		},
+		{
+			name:   "valkey",
+			engine: "valkey",
+			err:    "Downgrading valkey database clusters from version 8 to 7 is not supported",
+		},
+	}
+
+	for _, c := range cases {
+		config := map[string]interface{}{
+			"name":       "cluster-1",
+			"engine":     c.engine,
+			"version":    "7",
+			"size":       "db-s-1vcpu-1gb",
+			"region":     "nyc1",
+			"node_count": 1,
+		}
+
+		diff, err := resourceDigitalOceanDatabaseCluster().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		if c.err != "" {
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("%s: expected error %q, got %v", c.name, c.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		for _, key := range []string{"engine", "version"} {
+			if diff != nil && diff.Attributes[key] != nil {
+				t.Errorf("%s: expected no diff of %s, got %#v", c.name, key, diff.Attributes[key])
+			}
+		}
+	}
+}
+
+func TestResourceDigitalOceanDatabaseClusterMajorVersionUpgradeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "cluster-1",
+		Attributes: map[string]string{
+			"id":         "cluster-1",
+			"name":       "cluster-1",
+			"engine":     "pg",
+			"version":    "12",
+			"size":       "db-s-1vcpu-1gb",
+			"region":     "nyc1",
+			"node_count": "1",
+		},
+	}
+
+	config := map[string]interface{}{
+		"name":       "cluster-1",
+		"engine":     "pg",
+		"version":    "13",
+		"size":       "db-s-1vcpu-1gb",
+		"region":     "nyc1",
+		"node_count": 1,
+	}
+
+	_, err := resourceDigitalOceanDatabaseCluster().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	expected := "Upgrading pg database clusters from version 12 to 13 is a major version upgrade"
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
+
 func TestAccDigitalOceanDatabaseCluster_TagUpdate(t *testing.T) {
 	var database godo.Database
 	databaseName := randomTestName()
@@ -375,6 +602,34 @@ func TestAccDigitalOceanDatabaseCluster_TagUpdate(t *testing.T) {
 						"digitalocean_database_cluster.foobar", "tags.#", "2"),
 				),
 			},
+			{
+				// Remove a tag outside of Terraform to ensure the drift is detected and corrected.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+					_, err := client.Tags.UntagResources(context.Background(), "foo", &godo.UntagResourcesRequest{
+						Resources: []godo.Resource{{ID: database.ID, Type: godo.DatabaseResourceType}},
+					})
+					if err != nil {
+						t.Fatalf("Error removing tag from database cluster: %s", err)
+					}
+				},
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigTagUpdate, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					testAccCheckDigitalOceanDatabaseClusterTags(&database, []string{"production", "foo"}),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "tags.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseClusterConfigNoTags, databaseName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseClusterExists("digitalocean_database_cluster.foobar", &database),
+					testAccCheckDigitalOceanDatabaseClusterTags(&database, []string{}),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_cluster.foobar", "tags.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -488,6 +743,53 @@ func testAccCheckDigitalOceanDatabaseClusterExists(n string, database *godo.Data
 	}
 }
 
+func testAccCheckDigitalOceanDatabaseClusterInProject(n string, database *godo.Database) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+		urns, err := loadResourceURNs(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, urn := range *urns {
+			if urn == database.URN() {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Database cluster %s not found in project %s", database.URN(), rs.Primary.ID)
+	}
+}
+
+func testAccCheckDigitalOceanDatabaseClusterTags(database *godo.Database, tags []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(database.Tags) != len(tags) {
+			return fmt.Errorf("Bad tags: %v, expected: %v", database.Tags, tags)
+		}
+
+		for _, tag := range tags {
+			found := false
+			for _, t := range database.Tags {
+				if strings.EqualFold(t, tag) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("Tag %s not found on database cluster: %v", tag, database.Tags)
+			}
+		}
+
+		return nil
+	}
+}
+
 // testAccCheckDigitalOceanDatabaseClusterURIPassword checks that the password in
 // a database cluster's URI or private URI matches the password value stored in
 // its password attribute.
@@ -528,6 +830,18 @@ resource "digitalocean_database_cluster" "foobar" {
 	tags       = ["production"]
 }`
 
+const testAccCheckDigitalOceanDatabaseClusterConfigWithVersion = `
+resource "digitalocean_database_cluster" "foobar" {
+	name       = "%s"
+	engine     = "pg"
+	version    = "%s"
+	size       = "db-s-1vcpu-1gb"
+	region     = "nyc1"
+	node_count = 1
+
+	allow_major_version_upgrade = %t
+}`
+
 const testAccCheckDigitalOceanDatabaseClusterConfigWithUpdate = `
 resource "digitalocean_database_cluster" "foobar" {
 	name       = "%s"
@@ -668,6 +982,57 @@ resource "digitalocean_database_cluster" "foobar" {
 	tags       = ["production", "foo"]
 }`
 
+const testAccCheckDigitalOceanDatabaseClusterConfigWithStorageAutoscaling = `
+resource "digitalocean_database_cluster" "foobar" {
+	name       = "%s"
+	engine     = "pg"
+	version    = "11"
+	size       = "db-s-1vcpu-1gb"
+	region     = "nyc1"
+	node_count = 1
+
+	storage_autoscaling {
+		enabled      = %s
+		max_size_gib = %d
+	}
+}`
+
+const testAccCheckDigitalOceanDatabaseClusterConfigValkey = `
+resource "digitalocean_database_cluster" "foobar" {
+	name            = "%s"
+	engine          = "valkey"
+	version         = "%s"
+	size            = "db-s-1vcpu-1gb"
+	region          = "nyc1"
+	node_count      = 1
+	eviction_policy = "allkeys_lru"
+}`
+
+const testAccCheckDigitalOceanDatabaseClusterConfigWithProject = `
+resource "digitalocean_project" "foobar" {
+	name = "%s"
+}
+
+resource "digitalocean_database_cluster" "foobar" {
+	name       = "%s"
+	engine     = "pg"
+	version    = "11"
+	size       = "db-s-1vcpu-1gb"
+	region     = "nyc1"
+	node_count = 1
+	project_id = digitalocean_project.foobar.id
+}`
+
+const testAccCheckDigitalOceanDatabaseClusterConfigNoTags = `
+resource "digitalocean_database_cluster" "foobar" {
+	name       = "%s"
+	engine     = "pg"
+	version    = "11"
+	size       = "db-s-1vcpu-1gb"
+	region     = "nyc1"
+	node_count = 1
+}`
+
 const testAccCheckDigitalOceanDatabaseClusterConfigWithVPC = `
 resource "digitalocean_vpc" "foobar" {
   name        = "%s"
@@ -16,6 +16,7 @@ func resourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanDatabaseConnectionPoolCreate,
 		ReadContext:   resourceDigitalOceanDatabaseConnectionPoolRead,
+		UpdateContext: resourceDigitalOceanDatabaseConnectionPoolUpdate,
 		DeleteContext: resourceDigitalOceanDatabaseConnectionPoolDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceDigitalOceanDatabaseConnectionPoolImport,
@@ -46,7 +47,6 @@ func resourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 			"mode": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"session",
 					"transaction",
@@ -56,7 +56,6 @@ func resourceDigitalOceanDatabaseConnectionPool() *schema.Resource {
 			"size": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
 
@@ -135,7 +134,7 @@ func resourceDigitalOceanDatabaseConnectionPoolRead(ctx context.Context, d *sche
 	if err != nil {
 		// If the pool is somehow already destroyed, mark as
 		// successfully gone
-		if resp.StatusCode == 404 {
+		if resp != nil && resp.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
@@ -152,16 +151,51 @@ func resourceDigitalOceanDatabaseConnectionPoolRead(ctx context.Context, d *sche
 	d.Set("db_name", pool.Database)
 
 	// Computed values
-	d.Set("host", pool.Connection.Host)
-	d.Set("private_host", pool.PrivateConnection.Host)
-	d.Set("port", pool.Connection.Port)
-	d.Set("uri", pool.Connection.URI)
-	d.Set("private_uri", pool.PrivateConnection.URI)
-	d.Set("password", pool.Connection.Password)
+	if pool.Connection != nil {
+		d.Set("host", pool.Connection.Host)
+		d.Set("port", pool.Connection.Port)
+		d.Set("uri", pool.Connection.URI)
+		d.Set("password", pool.Connection.Password)
+	}
+
+	// The private connection is only returned for clusters in a VPC.
+	if pool.PrivateConnection != nil {
+		d.Set("private_host", pool.PrivateConnection.Host)
+		d.Set("private_uri", pool.PrivateConnection.URI)
+	}
 
 	return nil
 }
 
+func resourceDigitalOceanDatabaseConnectionPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+	clusterID, poolName := splitConnectionPoolID(d.Id())
+
+	if d.HasChanges("mode", "size") {
+		opts := &databaseUpdatePoolRequest{
+			User:     d.Get("user").(string),
+			Mode:     d.Get("mode").(string),
+			Size:     d.Get("size").(int),
+			Database: d.Get("db_name").(string),
+		}
+
+		log.Printf("[DEBUG] DatabaseConnectionPool update configuration: %#v", opts)
+		resp, err := updateDatabasePool(context.Background(), client, clusterID, poolName, opts)
+		if err != nil {
+			// If the pool is somehow already destroyed, mark as
+			// successfully gone
+			if resp != nil && resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+
+			return diag.Errorf("Error updating DatabaseConnectionPool: %s", err)
+		}
+	}
+
+	return resourceDigitalOceanDatabaseConnectionPoolRead(ctx, d, meta)
+}
+
 func resourceDigitalOceanDatabaseConnectionPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	if strings.Contains(d.Id(), ",") {
 		s := strings.Split(d.Id(), ",")
@@ -13,6 +13,7 @@ import (
 
 func TestAccDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 	var databaseConnectionPool godo.DatabasePool
+	var databaseConnectionPoolID string
 	databaseName := randomTestName()
 	databaseConnectionPoolName := randomTestName()
 
@@ -48,6 +49,10 @@ func TestAccDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 						"digitalocean_database_connection_pool.pool-01", "private_uri"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_database_connection_pool.pool-01", "password"),
+					func(s *terraform.State) error {
+						databaseConnectionPoolID = s.RootModule().Resources["digitalocean_database_connection_pool.pool-01"].Primary.ID
+						return nil
+					},
 				),
 			},
 			{
@@ -59,12 +64,51 @@ func TestAccDigitalOceanDatabaseConnectionPool_Basic(t *testing.T) {
 						"digitalocean_database_connection_pool.pool-01", "name", databaseConnectionPoolName),
 					resource.TestCheckResourceAttr(
 						"digitalocean_database_connection_pool.pool-01", "mode", "session"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_connection_pool.pool-01", "size", "20"),
+					resource.TestCheckResourceAttrPtr(
+						"digitalocean_database_connection_pool.pool-01", "id", &databaseConnectionPoolID),
 				),
 			},
 		},
 	})
 }
 
+func TestResourceDigitalOceanDatabaseConnectionPoolUpdateDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "cluster-1/pool-01",
+		Attributes: map[string]string{
+			"id":         "cluster-1/pool-01",
+			"cluster_id": "cluster-1",
+			"name":       "pool-01",
+			"user":       "doadmin",
+			"mode":       "transaction",
+			"size":       "10",
+			"db_name":    "defaultdb",
+		},
+	}
+
+	config := map[string]interface{}{
+		"cluster_id": "cluster-1",
+		"name":       "pool-01",
+		"user":       "doadmin",
+		"mode":       "session",
+		"size":       20,
+		"db_name":    "defaultdb",
+	}
+
+	diff, err := resourceDigitalOceanDatabaseConnectionPool().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff == nil || diff.Attributes["mode"] == nil || diff.Attributes["size"] == nil {
+		t.Fatalf("expected mode and size to change, got %#v", diff)
+	}
+	if diff.RequiresNew() {
+		t.Error("expected mode and size to be updated in place, the connection pool would be replaced")
+	}
+}
+
 func TestAccDigitalOceanDatabaseConnectionPool_BadModeName(t *testing.T) {
 	databaseName := randomTestName()
 	databaseConnectionPoolName := randomTestName()
@@ -178,7 +222,7 @@ resource "digitalocean_database_connection_pool" "pool-01" {
   cluster_id = digitalocean_database_cluster.foobar.id
   name       = "%s"
   mode       = "session"
-  size       = 10
+  size       = 20
   db_name    = "defaultdb"
   user       = "doadmin"
 }`
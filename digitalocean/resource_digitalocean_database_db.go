@@ -6,7 +6,6 @@ import (
 	"log"
 	"strings"
 
-	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -34,6 +33,20 @@ func resourceDigitalOceanDatabaseDB() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"charset": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"collation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
 		},
 	}
 }
@@ -42,12 +55,20 @@ func resourceDigitalOceanDatabaseDBCreate(ctx context.Context, d *schema.Resourc
 	client := meta.(*CombinedConfig).godoClient()
 	clusterID := d.Get("cluster_id").(string)
 
-	opts := &godo.DatabaseCreateDBRequest{
+	opts := &databaseDB{
 		Name: d.Get("name").(string),
 	}
 
+	if v, ok := d.GetOk("charset"); ok {
+		opts.Charset = v.(string)
+	}
+
+	if v, ok := d.GetOk("collation"); ok {
+		opts.Collation = v.(string)
+	}
+
 	log.Printf("[DEBUG] Database DB create configuration: %#v", opts)
-	db, _, err := client.Databases.CreateDB(context.Background(), clusterID, opts)
+	db, _, err := createDatabaseDB(context.Background(), client, clusterID, opts)
 	if err != nil {
 		return diag.Errorf("Error creating Database DB: %s", err)
 	}
@@ -64,7 +85,7 @@ func resourceDigitalOceanDatabaseDBRead(ctx context.Context, d *schema.ResourceD
 	name := d.Get("name").(string)
 
 	// Check if the database DB still exists
-	_, resp, err := client.Databases.GetDB(context.Background(), clusterID, name)
+	db, resp, err := getDatabaseDB(context.Background(), client, clusterID, name)
 	if err != nil {
 		// If the database DB is somehow already destroyed, mark as
 		// successfully gone
@@ -76,6 +97,14 @@ func resourceDigitalOceanDatabaseDBRead(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("Error retrieving Database DB: %s", err)
 	}
 
+	// Only MySQL clusters report a charset and collation.
+	if db.Charset != "" {
+		d.Set("charset", db.Charset)
+	}
+	if db.Collation != "" {
+		d.Set("collation", db.Collation)
+	}
+
 	return nil
 }
 
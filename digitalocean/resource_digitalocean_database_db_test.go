@@ -46,6 +46,33 @@ func TestAccDigitalOceanDatabaseDB_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseDB_MySQLCharset(t *testing.T) {
+	var databaseDB godo.DatabaseDB
+	databaseClusterName := fmt.Sprintf("foobar-test-terraform-%s", acctest.RandString(10))
+	databaseDBName := fmt.Sprintf("foobar-test-db-terraform-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseDBConfigMySQLCharset, databaseClusterName, databaseDBName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseDBExists("digitalocean_database_db.foobar_db", &databaseDB),
+					testAccCheckDigitalOceanDatabaseDBAttributes(&databaseDB, databaseDBName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_db.foobar_db", "name", databaseDBName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_db.foobar_db", "charset", "utf8mb4"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_db.foobar_db", "collation", "utf8mb4_unicode_ci"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDigitalOceanDatabaseDBDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
 
@@ -158,3 +185,20 @@ resource "digitalocean_database_db" "foobar_db" {
   cluster_id = "${digitalocean_database_cluster.foobar.id}"
   name       = "%s"
 }`
+
+const testAccCheckDigitalOceanDatabaseDBConfigMySQLCharset = `
+resource "digitalocean_database_cluster" "foobar" {
+  name       = "%s"
+  engine     = "mysql"
+  version    = "8"
+  size       = "db-s-1vcpu-1gb"
+  region     = "nyc1"
+  node_count = 1
+}
+
+resource "digitalocean_database_db" "foobar_db" {
+  cluster_id = digitalocean_database_cluster.foobar.id
+  name       = "%s"
+  charset    = "utf8mb4"
+  collation  = "utf8mb4_unicode_ci"
+}`
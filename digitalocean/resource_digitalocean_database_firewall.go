@@ -75,6 +75,10 @@ func resourceDigitalOceanDatabaseFirewallCreate(ctx context.Context, d *schema.R
 
 	rules := buildDatabaseFirewallRequest(d.Get("rule").(*schema.Set).List())
 
+	key := databaseFirewallMutexKey(clusterID)
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
 	_, err := client.Databases.UpdateFirewallRules(context.TODO(), clusterID, &rules)
 	if err != nil {
 		return diag.Errorf("Error creating DatabaseFirewall: %s", err)
@@ -108,6 +112,10 @@ func resourceDigitalOceanDatabaseFirewallUpdate(ctx context.Context, d *schema.R
 
 	rules := buildDatabaseFirewallRequest(d.Get("rule").(*schema.Set).List())
 
+	key := databaseFirewallMutexKey(clusterID)
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
 	_, err := client.Databases.UpdateFirewallRules(context.TODO(), clusterID, &rules)
 	if err != nil {
 		return diag.Errorf("Error updating DatabaseFirewall: %s", err)
@@ -120,6 +128,10 @@ func resourceDigitalOceanDatabaseFirewallDelete(ctx context.Context, d *schema.R
 	client := meta.(*CombinedConfig).godoClient()
 	clusterID := d.Get("cluster_id").(string)
 
+	key := databaseFirewallMutexKey(clusterID)
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
 	log.Printf("[INFO] Deleting DatabaseFirewall: %s", d.Id())
 	req := godo.DatabaseUpdateFirewallRulesRequest{
 		Rules: []*godo.DatabaseFirewallRule{},
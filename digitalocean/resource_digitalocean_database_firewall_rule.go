@@ -0,0 +1,236 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanDatabaseFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDatabaseFirewallRuleCreate,
+		ReadContext:   resourceDigitalOceanDatabaseFirewallRuleRead,
+		DeleteContext: resourceDigitalOceanDatabaseFirewallRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDigitalOceanDatabaseFirewallRuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ip_addr",
+					"droplet",
+					"k8s",
+					"tag",
+					"app",
+				}, false),
+			},
+
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"uuid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanDatabaseFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+	clusterID := d.Get("cluster_id").(string)
+	ruleType := d.Get("type").(string)
+	value := d.Get("value").(string)
+
+	// The API only supports replacing the full list of trusted sources, so
+	// serialize changes to the rules of a cluster.
+	key := databaseFirewallMutexKey(clusterID)
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
+	rules, _, err := client.Databases.GetFirewallRules(context.Background(), clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving DatabaseFirewall: %s", err)
+	}
+
+	if rule := findDatabaseFirewallRule(rules, ruleType, value); rule != nil {
+		return diag.Errorf("A database firewall rule of type %s for %s already exists (%s). Import it to manage it with Terraform.",
+			ruleType, value, makeDatabaseFirewallRuleID(clusterID, rule.UUID))
+	}
+
+	req := godo.DatabaseUpdateFirewallRulesRequest{
+		Rules: make([]*godo.DatabaseFirewallRule, 0, len(rules)+1),
+	}
+	for i := range rules {
+		req.Rules = append(req.Rules, &godo.DatabaseFirewallRule{
+			UUID:  rules[i].UUID,
+			Type:  rules[i].Type,
+			Value: rules[i].Value,
+		})
+	}
+	req.Rules = append(req.Rules, &godo.DatabaseFirewallRule{
+		Type:  ruleType,
+		Value: value,
+	})
+
+	log.Printf("[DEBUG] DatabaseFirewallRule create configuration: %#v", req)
+	_, err = client.Databases.UpdateFirewallRules(context.Background(), clusterID, &req)
+	if err != nil {
+		return diag.Errorf("Error creating DatabaseFirewallRule: %s", err)
+	}
+
+	rules, _, err = client.Databases.GetFirewallRules(context.Background(), clusterID)
+	if err != nil {
+		return diag.Errorf("Error retrieving DatabaseFirewall: %s", err)
+	}
+
+	rule := findDatabaseFirewallRule(rules, ruleType, value)
+	if rule == nil {
+		return diag.Errorf("Error creating DatabaseFirewallRule: rule of type %s for %s not found after creation", ruleType, value)
+	}
+
+	d.SetId(makeDatabaseFirewallRuleID(clusterID, rule.UUID))
+	log.Printf("[INFO] DatabaseFirewallRule UUID: %s", rule.UUID)
+
+	return resourceDigitalOceanDatabaseFirewallRuleRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDatabaseFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+	clusterID, uuid := splitDatabaseFirewallRuleID(d.Id())
+
+	rules, resp, err := client.Databases.GetFirewallRules(context.Background(), clusterID)
+	if err != nil {
+		// If the database cluster is somehow already destroyed, mark as
+		// successfully gone
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving DatabaseFirewall: %s", err)
+	}
+
+	for _, rule := range rules {
+		if rule.UUID == uuid {
+			d.Set("cluster_id", clusterID)
+			d.Set("uuid", rule.UUID)
+			d.Set("type", rule.Type)
+			d.Set("value", rule.Value)
+			d.Set("created_at", rule.CreatedAt.Format(time.RFC3339))
+
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] DatabaseFirewallRule (%s) not found", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanDatabaseFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+	clusterID, uuid := splitDatabaseFirewallRuleID(d.Id())
+
+	key := databaseFirewallMutexKey(clusterID)
+	mutexKV.Lock(key)
+	defer mutexKV.Unlock(key)
+
+	rules, resp, err := client.Databases.GetFirewallRules(context.Background(), clusterID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving DatabaseFirewall: %s", err)
+	}
+
+	req := godo.DatabaseUpdateFirewallRulesRequest{
+		Rules: make([]*godo.DatabaseFirewallRule, 0, len(rules)),
+	}
+	for i := range rules {
+		if rules[i].UUID == uuid {
+			continue
+		}
+
+		req.Rules = append(req.Rules, &godo.DatabaseFirewallRule{
+			UUID:  rules[i].UUID,
+			Type:  rules[i].Type,
+			Value: rules[i].Value,
+		})
+	}
+
+	log.Printf("[INFO] Deleting DatabaseFirewallRule: %s", d.Id())
+	_, err = client.Databases.UpdateFirewallRules(context.Background(), clusterID, &req)
+	if err != nil {
+		return diag.Errorf("Error deleting DatabaseFirewallRule: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanDatabaseFirewallRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		d.SetId(makeDatabaseFirewallRuleID(s[0], s[1]))
+		d.Set("cluster_id", s[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func findDatabaseFirewallRule(rules []godo.DatabaseFirewallRule, ruleType, value string) *godo.DatabaseFirewallRule {
+	for i := range rules {
+		if rules[i].Type == ruleType && rules[i].Value == value {
+			return &rules[i]
+		}
+	}
+
+	return nil
+}
+
+func databaseFirewallMutexKey(clusterID string) string {
+	return fmt.Sprintf("digitalocean_database_firewall/%s", clusterID)
+}
+
+func makeDatabaseFirewallRuleID(clusterID string, uuid string) string {
+	return fmt.Sprintf("%s/firewall/%s", clusterID, uuid)
+}
+
+func splitDatabaseFirewallRuleID(id string) (string, string) {
+	splitID := strings.Split(id, "/firewall/")
+	if len(splitID) != 2 {
+		return id, ""
+	}
+
+	return splitID[0], splitID[1]
+}
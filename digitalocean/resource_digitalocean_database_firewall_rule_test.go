@@ -0,0 +1,153 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanDatabaseFirewallRule_Basic(t *testing.T) {
+	databaseClusterName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseFirewallRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallRuleConfigBasic, databaseClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseFirewallRuleExists("digitalocean_database_firewall_rule.first"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_firewall_rule.first", "type", "ip_addr"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_firewall_rule.first", "value", "192.168.1.1"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_firewall_rule.first", "uuid"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_firewall_rule.first", "created_at"),
+				),
+			},
+			// Add a second rule without affecting the first
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallRuleConfigAddRule, databaseClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseFirewallRuleExists("digitalocean_database_firewall_rule.first"),
+					testAccCheckDigitalOceanDatabaseFirewallRuleExists("digitalocean_database_firewall_rule.second"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_firewall_rule.second", "value", "192.0.2.0"),
+				),
+			},
+			// Remove the second rule and leave the first in place
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseFirewallRuleConfigBasic, databaseClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseFirewallRuleExists("digitalocean_database_firewall_rule.first"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_database_firewall_rule.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDatabaseFirewallRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DatabaseFirewallRule ID is set")
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+		clusterID, uuid := splitDatabaseFirewallRuleID(rs.Primary.ID)
+
+		rules, _, err := client.Databases.GetFirewallRules(context.Background(), clusterID)
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			if rule.UUID == uuid {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("DatabaseFirewallRule not found: %s", rs.Primary.ID)
+	}
+}
+
+func testAccCheckDigitalOceanDatabaseFirewallRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_database_firewall_rule" {
+			continue
+		}
+
+		clusterID, uuid := splitDatabaseFirewallRuleID(rs.Primary.ID)
+
+		rules, _, err := client.Databases.GetFirewallRules(context.Background(), clusterID)
+		if err != nil {
+			// The cluster has been destroyed along with its rules.
+			continue
+		}
+
+		for _, rule := range rules {
+			if rule.UUID == uuid {
+				return fmt.Errorf("DatabaseFirewallRule still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+const testAccCheckDigitalOceanDatabaseFirewallRuleConfigBasic = `
+resource "digitalocean_database_cluster" "foobar" {
+	name       = "%s"
+	engine     = "pg"
+	version    = "11"
+	size       = "db-s-1vcpu-1gb"
+	region     = "nyc1"
+	node_count = 1
+}
+
+resource "digitalocean_database_firewall_rule" "first" {
+	cluster_id = digitalocean_database_cluster.foobar.id
+	type       = "ip_addr"
+	value      = "192.168.1.1"
+}
+`
+
+const testAccCheckDigitalOceanDatabaseFirewallRuleConfigAddRule = `
+resource "digitalocean_database_cluster" "foobar" {
+	name       = "%s"
+	engine     = "pg"
+	version    = "11"
+	size       = "db-s-1vcpu-1gb"
+	region     = "nyc1"
+	node_count = 1
+}
+
+resource "digitalocean_database_firewall_rule" "first" {
+	cluster_id = digitalocean_database_cluster.foobar.id
+	type       = "ip_addr"
+	value      = "192.168.1.1"
+}
+
+resource "digitalocean_database_firewall_rule" "second" {
+	cluster_id = digitalocean_database_cluster.foobar.id
+	type       = "ip_addr"
+	value      = "192.0.2.0"
+}
+`
@@ -111,6 +111,10 @@ func resourceDigitalOceanDatabaseReplica() *schema.Resource {
 				Set: HashStringIgnoreCase,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 	}
 }
 
@@ -135,7 +139,7 @@ func resourceDigitalOceanDatabaseReplicaCreate(ctx context.Context, d *schema.Re
 		return diag.Errorf("Error creating DatabaseReplica: %s", err)
 	}
 
-	replica, err = waitForDatabaseReplica(client, clusterId, "online", replica.Name)
+	replica, err = waitForDatabaseReplica(client, clusterId, "online", replica.Name, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.Errorf("Error creating DatabaseReplica: %s", err)
 	}
@@ -166,14 +170,20 @@ func resourceDigitalOceanDatabaseReplicaRead(ctx context.Context, d *schema.Reso
 	d.Set("tags", flattenTags(replica.Tags))
 
 	// Computed values
-	d.Set("host", replica.Connection.Host)
-	d.Set("private_host", replica.PrivateConnection.Host)
-	d.Set("port", replica.Connection.Port)
-	d.Set("uri", replica.Connection.URI)
-	d.Set("private_uri", replica.PrivateConnection.URI)
-	d.Set("database", replica.Connection.Database)
-	d.Set("user", replica.Connection.User)
-	d.Set("password", replica.Connection.Password)
+	if replica.Connection != nil {
+		d.Set("host", replica.Connection.Host)
+		d.Set("port", replica.Connection.Port)
+		d.Set("uri", replica.Connection.URI)
+		d.Set("database", replica.Connection.Database)
+		d.Set("user", replica.Connection.User)
+		d.Set("password", replica.Connection.Password)
+	}
+
+	// The private connection is only returned for replicas in a VPC.
+	if replica.PrivateConnection != nil {
+		d.Set("private_host", replica.PrivateConnection.Host)
+		d.Set("private_uri", replica.PrivateConnection.URI)
+	}
 	d.Set("private_network_uuid", replica.PrivateNetworkUUID)
 
 	return nil
@@ -209,9 +219,10 @@ func makeReplicaId(clusterId string, replicaName string) string {
 	return fmt.Sprintf("%s/replicas/%s", clusterId, replicaName)
 }
 
-func waitForDatabaseReplica(client *godo.Client, cluster_id, status, name string) (*godo.DatabaseReplica, error) {
-	ticker := time.NewTicker(15 * time.Second)
-	timeout := 120
+func waitForDatabaseReplica(client *godo.Client, cluster_id, status, name string, duration time.Duration) (*godo.DatabaseReplica, error) {
+	tickerInterval := 15 * time.Second
+	ticker := time.NewTicker(tickerInterval)
+	timeout := int(duration.Seconds() / tickerInterval.Seconds())
 	n := 0
 
 	for range ticker.C {
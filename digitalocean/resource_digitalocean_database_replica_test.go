@@ -95,6 +95,10 @@ func TestAccDigitalOceanDatabaseReplica_WithVPC(t *testing.T) {
 					testAccCheckDigitalOceanDatabaseClusterAttributes(&database, databaseName),
 					resource.TestCheckResourceAttrPair(
 						"digitalocean_database_replica.read-01", "private_network_uuid", "digitalocean_vpc.foobar", "id"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_replica.read-01", "private_host"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_replica.read-01", "private_uri"),
 				),
 			},
 		},
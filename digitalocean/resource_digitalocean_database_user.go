@@ -47,6 +47,24 @@ func resourceDigitalOceanDatabaseUser() *schema.Resource {
 					return old == godo.SQLAuthPluginCachingSHA2 && new == ""
 				},
 			},
+			"mongodb_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					mongoDBUserRoleReadOnly,
+					mongoDBUserRoleReadWrite,
+					mongoDBUserRoleDBAdmin,
+				}, false),
+			},
+			"mongodb_databases": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
 
 			// Computed Properties
 			"role": {
@@ -66,8 +84,11 @@ func resourceDigitalOceanDatabaseUserCreate(ctx context.Context, d *schema.Resou
 	client := meta.(*CombinedConfig).godoClient()
 	clusterID := d.Get("cluster_id").(string)
 
-	opts := &godo.DatabaseCreateUserRequest{
-		Name: d.Get("name").(string),
+	opts := &databaseCreateUserRequest{
+		DatabaseCreateUserRequest: godo.DatabaseCreateUserRequest{
+			Name: d.Get("name").(string),
+		},
+		Settings: expandMongoDBUserSettings(d),
 	}
 
 	if v, ok := d.GetOk("mysql_auth_plugin"); ok {
@@ -77,7 +98,7 @@ func resourceDigitalOceanDatabaseUserCreate(ctx context.Context, d *schema.Resou
 	}
 
 	log.Printf("[DEBUG] Database User create configuration: %#v", opts)
-	user, _, err := client.Databases.CreateUser(context.Background(), clusterID, opts)
+	user, _, err := createDatabaseUser(context.Background(), client, clusterID, opts)
 	if err != nil {
 		return diag.Errorf("Error creating Database User: %s", err)
 	}
@@ -98,7 +119,7 @@ func resourceDigitalOceanDatabaseUserRead(ctx context.Context, d *schema.Resourc
 	name := d.Get("name").(string)
 
 	// Check if the database user still exists
-	user, resp, err := client.Databases.GetUser(context.Background(), clusterID, name)
+	user, resp, err := getDatabaseUser(context.Background(), client, clusterID, name)
 	if err != nil {
 		// If the database user is somehow already destroyed, mark as
 		// successfully gone
@@ -120,6 +141,11 @@ func resourceDigitalOceanDatabaseUserRead(ctx context.Context, d *schema.Resourc
 		d.Set("mysql_auth_plugin", user.MySQLSettings.AuthPlugin)
 	}
 
+	if user.Settings != nil && user.Settings.MongoUserSettings != nil {
+		d.Set("mongodb_role", user.Settings.MongoUserSettings.Role)
+		d.Set("mongodb_databases", user.Settings.MongoUserSettings.Databases)
+	}
+
 	return nil
 }
 
@@ -145,6 +171,17 @@ func resourceDigitalOceanDatabaseUserUpdate(ctx context.Context, d *schema.Resou
 		}
 	}
 
+	if d.HasChanges("mongodb_role", "mongodb_databases") {
+		updateReq := &databaseUpdateUserRequest{
+			Settings: expandMongoDBUserSettings(d),
+		}
+
+		_, _, err := updateDatabaseUser(context.Background(), client, d.Get("cluster_id").(string), d.Get("name").(string), updateReq)
+		if err != nil {
+			return diag.Errorf("Error updating MongoDB settings for DatabaseUser: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanDatabaseUserRead(ctx, d, meta)
 }
 
@@ -177,3 +214,25 @@ func resourceDigitalOceanDatabaseUserImport(d *schema.ResourceData, meta interfa
 func makeDatabaseUserID(clusterID string, name string) string {
 	return fmt.Sprintf("%s/user/%s", clusterID, name)
 }
+
+// expandMongoDBUserSettings builds the MongoDB role and database scopes for a
+// user. It returns nil if neither is configured, leaving the cluster default.
+func expandMongoDBUserSettings(d *schema.ResourceData) *databaseUserSettings {
+	role, hasRole := d.GetOk("mongodb_role")
+	databases, hasDatabases := d.GetOk("mongodb_databases")
+	if !hasRole && !hasDatabases {
+		return nil
+	}
+
+	mongoSettings := &databaseMongoUserSettings{}
+	if hasRole {
+		mongoSettings.Role = role.(string)
+	}
+	if hasDatabases {
+		for _, db := range databases.(*schema.Set).List() {
+			mongoSettings.Databases = append(mongoSettings.Databases, db.(string))
+		}
+	}
+
+	return &databaseUserSettings{MongoUserSettings: mongoSettings}
+}
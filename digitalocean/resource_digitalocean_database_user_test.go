@@ -76,6 +76,43 @@ func TestAccDigitalOceanDatabaseUser_MongoDB(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDatabaseUser_MongoDBRoles(t *testing.T) {
+	var databaseUser godo.DatabaseUser
+	databaseClusterName := randomTestName()
+	databaseUserName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMongoRoles, databaseClusterName, databaseUserName, "readOnly"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
+					testAccCheckDigitalOceanDatabaseUserAttributes(&databaseUser, databaseUserName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "mongodb_role", "readOnly"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "mongodb_databases.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_user.foobar_user", "password"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanDatabaseUserConfigMongoRoles, databaseClusterName, databaseUserName, "readWrite"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDatabaseUserExists("digitalocean_database_user.foobar_user", &databaseUser),
+					resource.TestCheckResourceAttr(
+						"digitalocean_database_user.foobar_user", "mongodb_role", "readWrite"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_database_user.foobar_user", "password"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDatabaseUser_MySQLAuth(t *testing.T) {
 	var databaseUser godo.DatabaseUser
 	databaseClusterName := randomTestName()
@@ -268,6 +305,23 @@ resource "digitalocean_database_user" "foobar_user" {
   name       = "%s"
 }`
 
+const testAccCheckDigitalOceanDatabaseUserConfigMongoRoles = `
+resource "digitalocean_database_cluster" "foobar" {
+	name       = "%s"
+	engine     = "mongodb"
+	version    = "4"
+	size       = "db-s-1vcpu-1gb"
+	region     = "nyc1"
+	node_count = 1
+}
+
+resource "digitalocean_database_user" "foobar_user" {
+  cluster_id        = digitalocean_database_cluster.foobar.id
+  name              = "%s"
+  mongodb_role      = "%s"
+  mongodb_databases = ["admin"]
+}`
+
 const testAccCheckDigitalOceanDatabaseUserConfigMySQLAuth = `
 resource "digitalocean_database_cluster" "foobar" {
 	name       = "%s"
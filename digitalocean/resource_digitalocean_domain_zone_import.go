@@ -0,0 +1,345 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanDomainZoneImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDomainZoneImportCreate,
+		ReadContext:   resourceDigitalOceanDomainZoneImportRead,
+		UpdateContext: resourceDigitalOceanDomainZoneImportUpdate,
+		DeleteContext: resourceDigitalOceanDomainZoneImportDelete,
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"zone_file": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the contents of a BIND zone file",
+			},
+
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"flags": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"unsupported_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "records of the zone file which could not be imported",
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			if !diff.HasChange("zone_file") && !domainZoneImportHasMissingRecords(diff.Get("records").([]interface{})) {
+				return nil
+			}
+
+			if _, _, err := parseZoneFile(diff.Get("domain").(string), diff.Get("zone_file").(string)); err != nil {
+				return fmt.Errorf("Error parsing zone file: %s", err)
+			}
+
+			if err := diff.SetNewComputed("records"); err != nil {
+				return err
+			}
+
+			return diff.SetNewComputed("unsupported_records")
+		},
+	}
+}
+
+func resourceDigitalOceanDomainZoneImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	domain := d.Get("domain").(string)
+	records, unsupported, err := parseZoneFile(domain, d.Get("zone_file").(string))
+	if err != nil {
+		return diag.Errorf("Error parsing zone file: %s", err)
+	}
+
+	d.SetId(domain)
+
+	created := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		flattened, err := createDomainZoneImportRecord(client, domain, record)
+		if err != nil {
+			// Keep track of the records created so far so they are cleaned up.
+			d.Set("records", created)
+			return diag.FromErr(err)
+		}
+
+		created = append(created, flattened)
+	}
+
+	if err := d.Set("records", created); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Domain Zone Import records - error: %#v", err)
+	}
+	d.Set("unsupported_records", unsupported)
+
+	return append(domainZoneImportUnsupportedWarnings(unsupported), resourceDigitalOceanDomainZoneImportRead(ctx, d, meta)...)
+}
+
+func resourceDigitalOceanDomainZoneImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	domain := d.Get("domain").(string)
+
+	// Records are listed at once rather than retrieved one by one as zone
+	// files commonly hold hundreds of them.
+	ids := make(map[int]bool)
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		records, resp, err := client.Domains.Records(context.Background(), domain, opts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				log.Printf("[WARN] Domain (%s) not found", domain)
+				d.SetId("")
+				return nil
+			}
+
+			return diag.Errorf("Error retrieving records: %s", err)
+		}
+
+		for _, record := range records {
+			ids[record.ID] = true
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return diag.Errorf("Error retrieving records: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	// Records which no longer exist are kept with an ID of 0 so that they are
+	// created again, the remaining fields are kept as parsed so that records
+	// can be matched against the zone file.
+	records := d.Get("records").([]interface{})
+	for _, raw := range records {
+		record := raw.(map[string]interface{})
+
+		if id := record["id"].(int); id != 0 && !ids[id] {
+			log.Printf("[WARN] Record %d of domain %s not found", id, domain)
+			record["id"] = 0
+		}
+	}
+
+	if err := d.Set("records", records); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Domain Zone Import records - error: %#v", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDomainZoneImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	domain := d.Get("domain").(string)
+	records, unsupported, err := parseZoneFile(domain, d.Get("zone_file").(string))
+	if err != nil {
+		return diag.Errorf("Error parsing zone file: %s", err)
+	}
+
+	// Records are matched by all of their fields: unchanged records are kept,
+	// the others are deleted and created again.
+	previous := make(map[string]map[string]interface{})
+	oldRecords, _ := d.GetChange("records")
+	for _, raw := range oldRecords.([]interface{}) {
+		record := raw.(map[string]interface{})
+		if record["id"].(int) == 0 {
+			// The record was deleted outside of Terraform.
+			continue
+		}
+		previous[expandDomainZoneImportRecord(record).key()] = record
+	}
+
+	result := make([]map[string]interface{}, 0, len(records))
+	var added []zoneFileRecord
+	for _, record := range records {
+		if existing, ok := previous[record.key()]; ok {
+			result = append(result, existing)
+			delete(previous, record.key())
+		} else {
+			added = append(added, record)
+		}
+	}
+
+	for _, record := range previous {
+		if err := deleteDigitalOceanRecord(client, domain, record["id"].(int)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, record := range added {
+		flattened, err := createDomainZoneImportRecord(client, domain, record)
+		if err != nil {
+			d.Set("records", result)
+			return diag.FromErr(err)
+		}
+
+		result = append(result, flattened)
+	}
+
+	if err := d.Set("records", result); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Domain Zone Import records - error: %#v", err)
+	}
+	d.Set("unsupported_records", unsupported)
+
+	return append(domainZoneImportUnsupportedWarnings(unsupported), resourceDigitalOceanDomainZoneImportRead(ctx, d, meta)...)
+}
+
+func resourceDigitalOceanDomainZoneImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	domain := d.Get("domain").(string)
+	for _, raw := range d.Get("records").([]interface{}) {
+		record := raw.(map[string]interface{})
+		if record["id"].(int) == 0 {
+			continue
+		}
+
+		if err := deleteDigitalOceanRecord(client, domain, record["id"].(int)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func createDomainZoneImportRecord(client *godo.Client, domain string, record zoneFileRecord) (map[string]interface{}, error) {
+	log.Printf("[DEBUG] Zone import record create configuration: %#v", record.DomainRecordEditRequest)
+	rec, _, err := client.Domains.CreateRecord(context.Background(), domain, &record.DomainRecordEditRequest)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create %s record %s: %s", record.Type, record.Name, err)
+	}
+
+	return flattenDomainZoneImportRecord(rec.ID, record), nil
+}
+
+func flattenDomainZoneImportRecord(id int, record zoneFileRecord) map[string]interface{} {
+	return map[string]interface{}{
+		"id":       id,
+		"name":     record.Name,
+		"type":     record.Type,
+		"value":    record.Data,
+		"ttl":      record.TTL,
+		"priority": record.Priority,
+		"port":     record.Port,
+		"weight":   record.Weight,
+		"flags":    record.Flags,
+		"tag":      record.Tag,
+	}
+}
+
+func expandDomainZoneImportRecord(record map[string]interface{}) zoneFileRecord {
+	return zoneFileRecord{
+		DomainRecordEditRequest: godo.DomainRecordEditRequest{
+			Name:     record["name"].(string),
+			Type:     record["type"].(string),
+			Data:     record["value"].(string),
+			TTL:      record["ttl"].(int),
+			Priority: record["priority"].(int),
+			Port:     record["port"].(int),
+			Weight:   record["weight"].(int),
+			Flags:    record["flags"].(int),
+			Tag:      record["tag"].(string),
+		},
+	}
+}
+
+// domainZoneImportHasMissingRecords reports whether some of the records were
+// deleted outside of Terraform.
+func domainZoneImportHasMissingRecords(records []interface{}) bool {
+	for _, raw := range records {
+		if record, ok := raw.(map[string]interface{}); ok && record["id"].(int) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func domainZoneImportUnsupportedWarnings(unsupported []string) diag.Diagnostics {
+	if len(unsupported) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Some records of the zone file were not imported",
+			Detail:   fmt.Sprintf("The following records have a type which is not supported by DigitalOcean DNS:\n%s", strings.Join(unsupported, "\n")),
+		},
+	}
+}
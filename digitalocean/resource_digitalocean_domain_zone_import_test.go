@@ -0,0 +1,272 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testZoneFile = `
+$ORIGIN example.com.
+$TTL 3600
+@       IN  SOA ns1.example.net. hostmaster.example.com. (
+            2021010101 ; serial
+            7200       ; refresh
+            3600       ; retry
+            1209600    ; expire
+            3600 )     ; minimum
+        IN  NS   ns1.example.net.
+        IN  MX   10 mail
+        IN  A    192.0.2.1
+www     300 IN  CNAME @
+mail        IN  A    192.0.2.2
+            IN  AAAA 2001:db8::2
+_sip._tcp   IN  SRV  10 60 5060 sip.example.com.
+@       1h  IN  TXT  "v=spf1 mx " "-all"
+@           CAA  0 issue "letsencrypt.org"
+host        IN  HINFO "PC" "Linux"
+sub.example.com. IN A 192.0.2.3
+`
+
+func TestParseZoneFile(t *testing.T) {
+	records, unsupported, err := parseZoneFile("example.com", testZoneFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []godo.DomainRecordEditRequest{
+		{Type: "MX", Name: "@", Data: "mail.example.com.", Priority: 10, TTL: 3600},
+		{Type: "A", Name: "@", Data: "192.0.2.1", TTL: 3600},
+		{Type: "CNAME", Name: "www", Data: "@", TTL: 300},
+		{Type: "A", Name: "mail", Data: "192.0.2.2", TTL: 3600},
+		{Type: "AAAA", Name: "mail", Data: "2001:db8::2", TTL: 3600},
+		{Type: "SRV", Name: "_sip._tcp", Data: "sip.example.com.", Priority: 10, Weight: 60, Port: 5060, TTL: 3600},
+		{Type: "TXT", Name: "@", Data: `"v=spf1 mx " "-all"`, TTL: 3600},
+		{Type: "CAA", Name: "@", Data: "letsencrypt.org", Flags: 0, Tag: "issue", TTL: 3600},
+		{Type: "A", Name: "sub", Data: "192.0.2.3", TTL: 3600},
+	}
+
+	actual := make([]godo.DomainRecordEditRequest, 0, len(records))
+	for _, record := range records {
+		actual = append(actual, record.DomainRecordEditRequest)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("unexpected records:\n%#v\nexpected:\n%#v", actual, expected)
+	}
+
+	if len(unsupported) != 1 || unsupported[0] != "line 19: host HINFO" {
+		t.Fatalf("unexpected unsupported records: %#v", unsupported)
+	}
+}
+
+func TestParseZoneFile_TXT(t *testing.T) {
+	cases := map[string]string{
+		`@ IN TXT "v=spf1 -all"`:                        `v=spf1 -all`,
+		`@ IN TXT v=spf1`:                               `v=spf1`,
+		`@ IN TXT "v=DKIM1; k=rsa; " "p=MIIB" "IDAQAB"`: `"v=DKIM1; k=rsa; " "p=MIIB" "IDAQAB"`,
+		`@ IN TXT "say \"hi\"" "C:\\"`:                  `"say \"hi\"" "C:\\"`,
+	}
+
+	for zone, expected := range cases {
+		records, _, err := parseZoneFile("example.com", zone)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", zone, err)
+		}
+		if len(records) != 1 || records[0].Data != expected {
+			t.Errorf("%s: expected value %s, got %#v", zone, expected, records)
+			continue
+		}
+		if err := validateTXTRecordValue(records[0].Data); err != nil {
+			t.Errorf("%s: value %s is not accepted by digitalocean_record: %s", zone, records[0].Data, err)
+		}
+	}
+}
+
+func TestParseZoneFile_Errors(t *testing.T) {
+	cases := map[string]string{
+		"outside of domain":    "www.example.org. IN A 192.0.2.1",
+		"missing type":         "www IN 300",
+		"invalid MX":           "@ IN MX mail",
+		"unbalanced":           "@ IN SOA ns1 hostmaster ( 1 2 3 4 5",
+		"unterminated quote":   "@ IN TXT \"foo",
+		"include":              "$INCLUDE other.zone",
+		"invalid TTL":          "$TTL 1x",
+		"wrong number of args": "www IN A 192.0.2.1 192.0.2.2",
+	}
+
+	for name, zone := range cases {
+		if _, _, err := parseZoneFile("example.com", zone); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	cases := map[string]int{
+		"300":   300,
+		"1h":    3600,
+		"1h30m": 5400,
+		"1D":    86400,
+		"2w":    1209600,
+	}
+
+	for input, expected := range cases {
+		actual, err := parseZoneFileTTL(input)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", input, err)
+		}
+		if actual != expected {
+			t.Fatalf("%s: expected %d, got %d", input, expected, actual)
+		}
+	}
+}
+
+func TestResourceDigitalOceanDomainZoneImportRead_MissingRecords(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet && r.URL.Path == "/v2/domains/example.com/records" {
+			fmt.Fprint(w, `{"domain_records":[{"id":1,"type":"A","name":"www","data":"192.0.2.1","ttl":1800}],"links":{},"meta":{"total":1}}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"id":"not_found","message":"The resource you were accessing could not be found."}`)
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	meta := &CombinedConfig{client: client}
+
+	zoneFile := "www IN A 192.0.2.1\nmail IN A 192.0.2.2\n"
+	records, _, err := parseZoneFile("example.com", zoneFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := resourceDigitalOceanDomainZoneImport()
+	d := r.TestResourceData()
+	d.SetId("example.com")
+	d.Set("domain", "example.com")
+	d.Set("zone_file", zoneFile)
+	d.Set("records", []interface{}{
+		flattenDomainZoneImportRecord(1, records[0]),
+		flattenDomainZoneImportRecord(2, records[1]),
+	})
+
+	if diags := resourceDigitalOceanDomainZoneImportRead(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if n := d.Get("records.#").(int); n != 2 {
+		t.Fatalf("expected the records deleted outside of Terraform to be kept, got %d records", n)
+	}
+	if id := d.Get("records.1.id").(int); id != 0 {
+		t.Fatalf("expected the ID of the deleted record to be reset, got %d", id)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"domain":    "example.com",
+		"zone_file": zoneFile,
+	})
+	diff, err := r.Diff(context.Background(), d.State(), config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff == nil || diff.Empty() {
+		t.Fatalf("expected a diff to create the deleted record again")
+	}
+}
+
+func TestAccDigitalOceanDomainZoneImport_Basic(t *testing.T) {
+	domain := fmt.Sprintf("foobar-test-terraform-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDomainZoneImportConfig(domain, `
+www  IN A   192.0.2.1
+mail IN A   192.0.2.2
+@    IN MX  10 mail
+host IN HINFO "PC" "Linux"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDomainZoneImportRecordsExist("digitalocean_domain_zone_import.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_domain_zone_import.foobar", "records.#", "3"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_domain_zone_import.foobar", "unsupported_records.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanDomainZoneImportConfig(domain, `
+www  IN A   192.0.2.1
+mail IN A   192.0.2.3
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDomainZoneImportRecordsExist("digitalocean_domain_zone_import.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_domain_zone_import.foobar", "records.#", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_domain_zone_import.foobar", "unsupported_records.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDomainZoneImportRecordsExist(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["records.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			id, err := strconv.Atoi(rs.Primary.Attributes[fmt.Sprintf("records.%d.id", i)])
+			if err != nil {
+				return err
+			}
+
+			if _, _, err := client.Domains.Record(context.Background(), rs.Primary.ID, id); err != nil {
+				return fmt.Errorf("Record %d not found: %s", id, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanDomainZoneImportConfig(domain, zoneFile string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_domain" "foobar" {
+  name = "%s"
+}
+
+resource "digitalocean_domain_zone_import" "foobar" {
+  domain    = digitalocean_domain.foobar.name
+  zone_file = <<EOT
+%s
+EOT
+}`, domain, zoneFile)
+}
@@ -192,6 +192,8 @@ func resourceDigitalOceanDroplet() *schema.Resource {
 
 			"tags": tagsSchema(),
 
+			"project_id": projectIDSchema(),
+
 			"vpc_uuid": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -296,6 +298,13 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf(
 			"Error waiting for droplet (%s) to become ready: %s", d.Id(), err)
 	}
+
+	if _, ok := d.GetOk("project_id"); ok {
+		if err := assignToProject(client, d, droplet.URN()); err != nil {
+			return diag.Errorf("Error assigning droplet (%s) to project: %s", d.Id(), err)
+		}
+	}
+
 	return resourceDigitalOceanDropletRead(ctx, d, meta)
 }
 
@@ -363,6 +372,10 @@ func resourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceData
 		"host": findIPv4AddrByType(droplet, "public"),
 	})
 
+	if err := readProjectAssignment(meta, d, droplet.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -388,6 +401,10 @@ func resourceDigitalOceanDropletImport(d *schema.ResourceData, meta interface{})
 	// This is a non API attribute. So set to the default setting in the schema.
 	d.Set("resize_disk", true)
 
+	if err := importProjectAssignment(meta, d, droplet.URN()); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -452,7 +469,7 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 
 		// Wait for the resize action to complete.
-		if err = waitForAction(client, action); err != nil {
+		if err = waitForAction(ctx, meta, action); err != nil {
 			newErr := powerOnAndWait(ctx, d, meta)
 			if newErr != nil {
 				return diag.Errorf(
@@ -506,7 +523,7 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 					"Error enabling backups on droplet (%s): %s", d.Id(), err)
 			}
 
-			if err := waitForAction(client, action); err != nil {
+			if err := waitForAction(ctx, meta, action); err != nil {
 				return diag.Errorf("Error waiting for backups to be enabled for droplet (%s): %s", d.Id(), err)
 			}
 		} else {
@@ -517,7 +534,7 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 					"Error disabling backups on droplet (%s): %s", d.Id(), err)
 			}
 
-			if err := waitForAction(client, action); err != nil {
+			if err := waitForAction(ctx, meta, action); err != nil {
 				return diag.Errorf("Error waiting for backups to be disabled for droplet (%s): %s", d.Id(), err)
 			}
 		}
@@ -569,6 +586,12 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if d.HasChange("project_id") {
+		if err := assignToProject(client, d, godo.Droplet{ID: id}.URN()); err != nil {
+			return diag.Errorf("Error assigning droplet (%s) to project: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("volume_ids") {
 		oldIDs, newIDs := d.GetChange("volume_ids")
 		newSet := func(ids []interface{}) map[string]struct{} {
@@ -596,12 +619,12 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 				return diag.Errorf("Error attaching volume %q to droplet (%s): %s", volumeID, d.Id(), err)
 			}
 			// can't fire >1 action at a time, so waiting for each is OK
-			if err := waitForAction(client, action); err != nil {
+			if err := waitForAction(ctx, meta, action); err != nil {
 				return diag.Errorf("Error waiting for volume %q to attach to droplet (%s): %s", volumeID, d.Id(), err)
 			}
 		}
 		for volumeID := range leftDiff(oldIDSet, newIDSet) {
-			detachVolumeIDOnDroplet(d, volumeID, meta)
+			detachVolumeIDOnDroplet(ctx, d, volumeID, meta)
 		}
 	}
 
@@ -625,7 +648,7 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[INFO] Trying to Detach Storage Volumes (if any) from droplet: %s", d.Id())
-	err = detachVolumesFromDroplet(d, meta)
+	err = detachVolumesFromDroplet(ctx, d, meta)
 	if err != nil {
 		return diag.Errorf(
 			"Error detaching the volumes from the droplet (%s): %s", d.Id(), err)
@@ -654,16 +677,8 @@ func resourceDigitalOceanDropletDelete(ctx context.Context, d *schema.ResourceDa
 func waitForDropletDestroy(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	log.Printf("[INFO] Waiting for droplet (%s) to be destroyed", d.Id())
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"active", "off"},
-		Target:     []string{"archived"},
-		Refresh:    newDropletStateRefreshFunc(ctx, d, "status", meta),
-		Timeout:    60 * time.Second,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	return stateConf.WaitForState()
+	return meta.(*CombinedConfig).poller().waitForState(ctx, 60*time.Second,
+		[]string{"active", "off"}, []string{"archived"}, newDropletStateRefreshFunc(ctx, d, "status", meta))
 }
 
 func waitForDropletAttribute(
@@ -674,21 +689,8 @@ func waitForDropletAttribute(
 		"[INFO] Waiting for droplet (%s) to have %s of %s",
 		d.Id(), attribute, target)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    pending,
-		Target:     []string{target},
-		Refresh:    newDropletStateRefreshFunc(ctx, d, attribute, meta),
-		Timeout:    60 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-
-		// This is a hack around DO API strangeness.
-		// https://github.com/hashicorp/terraform/issues/481
-		//
-		NotFoundChecks: 60,
-	}
-
-	return stateConf.WaitForState()
+	return meta.(*CombinedConfig).poller().waitForState(ctx, 60*time.Minute,
+		pending, []string{target}, newDropletStateRefreshFunc(ctx, d, attribute, meta))
 }
 
 // TODO This function still needs a little more refactoring to make it
@@ -759,12 +761,12 @@ func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{
 }
 
 // Detach volumes from droplet
-func detachVolumesFromDroplet(d *schema.ResourceData, meta interface{}) error {
+func detachVolumesFromDroplet(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	var errors []error
 	if attr, ok := d.GetOk("volume_ids"); ok {
 		errors = make([]error, 0, attr.(*schema.Set).Len())
 		for _, volumeID := range attr.(*schema.Set).List() {
-			detachVolumeIDOnDroplet(d, volumeID.(string), meta)
+			detachVolumeIDOnDroplet(ctx, d, volumeID.(string), meta)
 		}
 	}
 
@@ -775,7 +777,7 @@ func detachVolumesFromDroplet(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func detachVolumeIDOnDroplet(d *schema.ResourceData, volumeID string, meta interface{}) error {
+func detachVolumeIDOnDroplet(ctx context.Context, d *schema.ResourceData, volumeID string, meta interface{}) error {
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return fmt.Errorf("invalid droplet id: %v", err)
@@ -786,7 +788,7 @@ func detachVolumeIDOnDroplet(d *schema.ResourceData, volumeID string, meta inter
 		return fmt.Errorf("Error detaching volume %q from droplet (%s): %s", volumeID, d.Id(), err)
 	}
 	// can't fire >1 action at a time, so waiting for each is OK
-	if err := waitForAction(client, action); err != nil {
+	if err := waitForAction(ctx, meta, action); err != nil {
 		return fmt.Errorf("Error waiting for volume %q to detach from droplet (%s): %s", volumeID, d.Id(), err)
 	}
 
@@ -65,7 +65,7 @@ func resourceDigitalOceanDropletSnapshotCreate(ctx context.Context, d *schema.Re
 		return diag.Errorf("Error creating Droplet Snapshot: %s", err)
 	}
 
-	if err = waitForAction(client, action); err != nil {
+	if err = waitForAction(ctx, meta, action); err != nil {
 		return diag.Errorf(
 			"Error waiting for Droplet snapshot (%v) to finish: %s", resourceId, err)
 	}
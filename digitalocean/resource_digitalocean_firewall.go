@@ -105,11 +105,13 @@ func resourceDigitalOceanFirewallRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("[DEBUG] Error setting `droplet_ids`: %+v", err)
 	}
 
-	if err := d.Set("inbound_rule", flattenFirewallInboundRules(firewall.InboundRules)); err != nil {
+	inboundRules := setFirewallRuleDescriptions(flattenFirewallInboundRules(firewall.InboundRules), d.Get("inbound_rule").(*schema.Set), "source_")
+	if err := d.Set("inbound_rule", inboundRules); err != nil {
 		return diag.Errorf("[DEBUG] Error setting Firewall inbound_rule error: %#v", err)
 	}
 
-	if err := d.Set("outbound_rule", flattenFirewallOutboundRules(firewall.OutboundRules)); err != nil {
+	outboundRules := setFirewallRuleDescriptions(flattenFirewallOutboundRules(firewall.OutboundRules), d.Get("outbound_rule").(*schema.Set), "destination_")
+	if err := d.Set("outbound_rule", outboundRules); err != nil {
 		return diag.Errorf("[DEBUG] Error setting Firewall outbound_rule error: %#v", err)
 	}
 
@@ -0,0 +1,149 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanFirewallRuleCreate,
+		ReadContext:   resourceDigitalOceanFirewallRuleRead,
+		DeleteContext: resourceDigitalOceanFirewallRuleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"firewall_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"inbound_rule": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Elem:         firewallRuleForceNewSchema("source"),
+				ExactlyOneOf: []string{"inbound_rule", "outbound_rule"},
+			},
+
+			"outbound_rule": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Elem:         firewallRuleForceNewSchema("destination"),
+				ExactlyOneOf: []string{"inbound_rule", "outbound_rule"},
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			for _, direction := range []string{"inbound", "outbound"} {
+				if protocol, ok := diff.GetOk(direction + "_rule.0.protocol"); ok {
+					if _, hasPort := diff.GetOk(direction + "_rule.0.port_range"); protocol != "icmp" && !hasPort {
+						return fmt.Errorf("`port_range` of %s rules is required if protocol is `tcp` or `udp`", direction)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// firewallRuleRequest builds a request containing only the single rule
+// managed by a digitalocean_firewall_rule resource.
+func firewallRuleRequest(d *schema.ResourceData) *godo.FirewallRulesRequest {
+	return &godo.FirewallRulesRequest{
+		InboundRules:  expandFirewallInboundRules(d.Get("inbound_rule").([]interface{})),
+		OutboundRules: expandFirewallOutboundRules(d.Get("outbound_rule").([]interface{})),
+	}
+}
+
+func firewallRuleRequestKey(rules *godo.FirewallRulesRequest) string {
+	if len(rules.InboundRules) > 0 {
+		return firewallInboundRuleKey(rules.InboundRules[0])
+	}
+
+	return firewallOutboundRuleKey(rules.OutboundRules[0])
+}
+
+func resourceDigitalOceanFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	firewallID := d.Get("firewall_id").(string)
+	rules := firewallRuleRequest(d)
+
+	log.Printf("[DEBUG] Adding rule to firewall (%s): %#v", firewallID, rules)
+	_, err := client.Firewalls.AddRules(context.Background(), firewallID, rules)
+	if err != nil {
+		return diag.Errorf("Error adding rule to firewall (%s): %s", firewallID, err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", firewallID)))
+
+	return resourceDigitalOceanFirewallRuleRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	firewallID := d.Get("firewall_id").(string)
+
+	firewall, resp, err := client.Firewalls.Get(context.Background(), firewallID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] DigitalOcean Firewall (%s) not found", firewallID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving firewall: %s", err)
+	}
+
+	// The rule is only ever replaced, so there is nothing to refresh other
+	// than whether it is still present on the firewall.
+	key := firewallRuleRequestKey(firewallRuleRequest(d))
+	for _, rule := range firewall.InboundRules {
+		if firewallInboundRuleKey(rule) == key {
+			return nil
+		}
+	}
+	for _, rule := range firewall.OutboundRules {
+		if firewallOutboundRuleKey(rule) == key {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Rule (%s) not found on DigitalOcean Firewall (%s)", d.Id(), firewallID)
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	firewallID := d.Get("firewall_id").(string)
+	rules := firewallRuleRequest(d)
+
+	log.Printf("[INFO] Removing rule from firewall (%s): %#v", firewallID, rules)
+	resp, err := client.Firewalls.RemoveRules(context.Background(), firewallID, rules)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+
+		return diag.Errorf("Error removing rule from firewall (%s): %s", firewallID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
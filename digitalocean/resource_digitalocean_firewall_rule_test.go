@@ -0,0 +1,114 @@
+package digitalocean
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanFirewallRule_Basic(t *testing.T) {
+	var firewall godo.Firewall
+	rName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanFirewallRuleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanFirewallExists("digitalocean_firewall.foobar", &firewall),
+					testAccCheckDigitalOceanFirewallRuleCount(&firewall, 2, 1),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_firewall_rule.http", "firewall_id",
+						"digitalocean_firewall.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_firewall_rule.http", "inbound_rule.0.port_range", "80"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_firewall_rule.dns", "outbound_rule.0.protocol", "udp"),
+				),
+			},
+			{
+				Config: testAccDigitalOceanFirewallRuleConfig_removed(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanFirewallExists("digitalocean_firewall.foobar", &firewall),
+					testAccCheckDigitalOceanFirewallRuleCount(&firewall, 1, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanFirewallRuleCount(firewall *godo.Firewall, inbound, outbound int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(firewall.InboundRules) != inbound {
+			return fmt.Errorf("Expected %d inbound rules, found %d", inbound, len(firewall.InboundRules))
+		}
+
+		if len(firewall.OutboundRules) != outbound {
+			return fmt.Errorf("Expected %d outbound rules, found %d", outbound, len(firewall.OutboundRules))
+		}
+
+		return nil
+	}
+}
+
+func testAccDigitalOceanFirewallRuleConfig_firewall(rName string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_firewall" "foobar" {
+  name = "foobar-%s"
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "22"
+    source_addresses = ["0.0.0.0/0", "::/0"]
+  }
+
+  lifecycle {
+    ignore_changes = [inbound_rule, outbound_rule]
+  }
+}
+`, rName)
+}
+
+func testAccDigitalOceanFirewallRuleConfig_basic(rName string) string {
+	return testAccDigitalOceanFirewallRuleConfig_firewall(rName) + `
+resource "digitalocean_firewall_rule" "http" {
+  firewall_id = digitalocean_firewall.foobar.id
+
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "80"
+    source_addresses = ["0.0.0.0/0", "::/0"]
+  }
+}
+
+resource "digitalocean_firewall_rule" "dns" {
+  firewall_id = digitalocean_firewall.foobar.id
+
+  outbound_rule {
+    protocol              = "udp"
+    port_range            = "53"
+    destination_addresses = ["0.0.0.0/0", "::/0"]
+  }
+}
+`
+}
+
+func testAccDigitalOceanFirewallRuleConfig_removed(rName string) string {
+	return testAccDigitalOceanFirewallRuleConfig_firewall(rName) + `
+resource "digitalocean_firewall_rule" "dns" {
+  firewall_id = digitalocean_firewall.foobar.id
+
+  outbound_rule {
+    protocol              = "udp"
+    port_range            = "53"
+    destination_addresses = ["0.0.0.0/0", "::/0"]
+  }
+}
+`
+}
@@ -214,6 +214,64 @@ func TestAccDigitalOceanFirewall_ImportMultipleRules(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanFirewall_ruleDescriptions(t *testing.T) {
+	rName := acctest.RandString(10)
+	var firewall godo.Firewall
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanFirewallConfig_ruleDescriptions(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanFirewallExists("digitalocean_firewall.foobar", &firewall),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "inbound_rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("digitalocean_firewall.foobar", "inbound_rule.*", map[string]string{
+						"port_range":  "22",
+						"description": "ssh",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("digitalocean_firewall.foobar", "inbound_rule.*", map[string]string{
+						"port_range":  "443",
+						"description": "https",
+					}),
+				),
+			},
+			{
+				// Reordering the rules and their sources must not produce a diff.
+				Config:   testAccDigitalOceanFirewallConfig_ruleDescriptions(rName, true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanFirewall_kubernetesTargets(t *testing.T) {
+	rName := acctest.RandString(10)
+	var firewall godo.Firewall
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanFirewallConfig_kubernetesTargets(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanFirewallExists("digitalocean_firewall.foobar", &firewall),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "inbound_rule.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("digitalocean_firewall.foobar", "inbound_rule.*.source_kubernetes_ids.*",
+						"digitalocean_kubernetes_cluster.foobar", "id"),
+					resource.TestCheckResourceAttr("digitalocean_firewall.foobar", "outbound_rule.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("digitalocean_firewall.foobar", "outbound_rule.*.destination_kubernetes_ids.*",
+						"digitalocean_kubernetes_cluster.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDigitalOceanFirewallConfig_OnlyInbound(rName string) string {
 	return fmt.Sprintf(`
 	resource "digitalocean_firewall" "foobar" {
@@ -350,6 +408,69 @@ resource "digitalocean_firewall" "foobar" {
 `, rName)
 }
 
+func testAccDigitalOceanFirewallConfig_ruleDescriptions(rName string, reordered bool) string {
+	ssh := `
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "22"
+    source_addresses = ["0.0.0.0/0", "::/0"]
+    description      = "ssh"
+  }
+`
+	https := `
+  inbound_rule {
+    protocol         = "tcp"
+    port_range       = "443"
+    source_addresses = ["0.0.0.0/0", "::/0"]
+    description      = "https"
+  }
+`
+	rules := ssh + https
+	if reordered {
+		rules = strings.Replace(https+ssh, `["0.0.0.0/0", "::/0"]`, `["::/0", "0.0.0.0/0"]`, -1)
+	}
+
+	return fmt.Sprintf(`
+resource "digitalocean_firewall" "foobar" {
+  name = "foobar-%s"
+%s
+}
+`, rName, rules)
+}
+
+func testAccDigitalOceanFirewallConfig_kubernetesTargets(rName string) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "foobar-%s"
+  region  = "nyc3"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+
+resource "digitalocean_firewall" "foobar" {
+  name = "foobar-%s"
+
+  inbound_rule {
+    protocol              = "tcp"
+    port_range            = "443"
+    source_kubernetes_ids = [digitalocean_kubernetes_cluster.foobar.id]
+  }
+
+  outbound_rule {
+    protocol                   = "tcp"
+    port_range                 = "5432"
+    destination_kubernetes_ids = [digitalocean_kubernetes_cluster.foobar.id]
+  }
+}
+`, testClusterVersion19, rName, rName)
+}
+
 func testAccCheckDigitalOceanFirewallDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
 
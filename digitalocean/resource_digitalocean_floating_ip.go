@@ -21,7 +21,7 @@ func resourceDigitalOceanFloatingIp() *schema.Resource {
 		ReadContext:   resourceDigitalOceanFloatingIpRead,
 		DeleteContext: resourceDigitalOceanFloatingIpDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceDigitalOceanFloatingIpImport,
+			StateContext: resourceDigitalOceanFloatingIpImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,6 +50,8 @@ func resourceDigitalOceanFloatingIp() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+
+			"project_id": projectIDSchema(),
 		},
 	}
 }
@@ -70,6 +72,12 @@ func resourceDigitalOceanFloatingIpCreate(ctx context.Context, d *schema.Resourc
 
 	d.SetId(floatingIp.IP)
 
+	if _, ok := d.GetOk("project_id"); ok {
+		if err := assignToProject(client, d, floatingIp.URN()); err != nil {
+			return diag.Errorf("Error assigning FloatingIP to project: %s", err)
+		}
+	}
+
 	if v, ok := d.GetOk("droplet_id"); ok {
 
 		log.Printf("[INFO] Assigning the Floating IP to the Droplet %d", v.(int))
@@ -122,6 +130,12 @@ func resourceDigitalOceanFloatingIpUpdate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	if d.HasChange("project_id") {
+		if err := assignToProject(client, d, godo.FloatingIP{IP: d.Id()}.URN()); err != nil {
+			return diag.Errorf("Error assigning FloatingIP to project: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanFloatingIpRead(ctx, d, meta)
 }
 
@@ -146,6 +160,10 @@ func resourceDigitalOceanFloatingIpRead(ctx context.Context, d *schema.ResourceD
 
 		d.Set("ip_address", floatingIp.IP)
 		d.Set("urn", floatingIp.URN())
+
+		if err := readProjectAssignment(meta, d, floatingIp.URN()); err != nil {
+			return diag.FromErr(err)
+		}
 	} else {
 		d.SetId("")
 	}
@@ -153,21 +171,27 @@ func resourceDigitalOceanFloatingIpRead(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
-func resourceDigitalOceanFloatingIpImport(rs *schema.ResourceData, v interface{}) ([]*schema.ResourceData, error) {
+func resourceDigitalOceanFloatingIpImport(ctx context.Context, rs *schema.ResourceData, v interface{}) ([]*schema.ResourceData, error) {
 	client := v.(*CombinedConfig).godoClient()
 	floatingIp, resp, err := client.FloatingIPs.Get(context.Background(), rs.Id())
-	if resp.StatusCode != 404 {
-		if err != nil {
-			return nil, err
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, fmt.Errorf("FloatingIP (%s) not found", rs.Id())
 		}
 
-		rs.Set("ip_address", floatingIp.IP)
-		rs.Set("urn", floatingIp.URN())
-		rs.Set("region", floatingIp.Region.Slug)
+		return nil, fmt.Errorf("Error retrieving FloatingIP: %s", err)
+	}
 
-		if floatingIp.Droplet != nil {
-			rs.Set("droplet_id", floatingIp.Droplet.ID)
-		}
+	rs.Set("ip_address", floatingIp.IP)
+	rs.Set("urn", floatingIp.URN())
+	rs.Set("region", floatingIp.Region.Slug)
+
+	if floatingIp.Droplet != nil {
+		rs.Set("droplet_id", floatingIp.Droplet.ID)
+	}
+
+	if err := importProjectAssignment(v, rs, floatingIp.URN()); err != nil {
+		return nil, err
 	}
 
 	return []*schema.ResourceData{rs}, nil
@@ -211,18 +235,8 @@ func waitForFloatingIPReady(
 		"[INFO] Waiting for FloatingIP (%s) to have %s of %s",
 		d.Id(), attribute, target)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    pending,
-		Target:     []string{target},
-		Refresh:    newFloatingIPStateRefreshFunc(d, attribute, meta, actionId),
-		Timeout:    60 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-
-		NotFoundChecks: 60,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	return meta.(*CombinedConfig).poller().waitForState(ctx, 60*time.Minute,
+		pending, []string{target}, newFloatingIPStateRefreshFunc(d, attribute, meta, actionId))
 }
 
 func newFloatingIPStateRefreshFunc(
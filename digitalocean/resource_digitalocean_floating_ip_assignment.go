@@ -120,18 +120,8 @@ func waitForFloatingIPAssignmentReady(
 		"[INFO] Waiting for FloatingIP (%s) to have %s of %s",
 		d.Get("ip_address").(string), attribute, target)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    pending,
-		Target:     []string{target},
-		Refresh:    newFloatingIPAssignmentStateRefreshFunc(d, attribute, meta, actionId),
-		Timeout:    60 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-
-		NotFoundChecks: 60,
-	}
-
-	return stateConf.WaitForStateContext(ctx)
+	return meta.(*CombinedConfig).poller().waitForState(ctx, 60*time.Minute,
+		pending, []string{target}, newFloatingIPAssignmentStateRefreshFunc(d, attribute, meta, actionId))
 }
 
 func newFloatingIPAssignmentStateRefreshFunc(
@@ -102,6 +102,51 @@ func TestAccDigitalOceanFloatingIP_Droplet(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanFloatingIP_WithProject(t *testing.T) {
+	var floatingIP godo.FloatingIP
+	projectName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFloatingIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanFloatingIPConfig_withProject, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanFloatingIPExists("digitalocean_floating_ip.foobar", &floatingIP),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_floating_ip.foobar", "project_id", "digitalocean_project.foobar", "id"),
+					testAccCheckDigitalOceanFloatingIPInProject("digitalocean_project.foobar", &floatingIP),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanFloatingIPInProject(n string, floatingIP *godo.FloatingIP) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+		urns, err := loadResourceURNs(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, urn := range *urns {
+			if urn == floatingIP.URN() {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("FloatingIP %s not found in project %s", floatingIP.URN(), rs.Primary.ID)
+	}
+}
+
 func testAccCheckDigitalOceanFloatingIPDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
 
@@ -157,6 +202,16 @@ resource "digitalocean_floating_ip" "foobar" {
   region = "nyc3"
 }`
 
+var testAccCheckDigitalOceanFloatingIPConfig_withProject = `
+resource "digitalocean_project" "foobar" {
+  name = "%s"
+}
+
+resource "digitalocean_floating_ip" "foobar" {
+  region     = "nyc3"
+  project_id = digitalocean_project.foobar.id
+}`
+
 func testAccCheckDigitalOceanFloatingIPConfig_droplet(rInt int) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
@@ -0,0 +1,357 @@
+package digitalocean
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanGlobalLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanGlobalLoadBalancerCreate,
+		ReadContext:   resourceDigitalOceanGlobalLoadBalancerRead,
+		UpdateContext: resourceDigitalOceanGlobalLoadBalancerUpdate,
+		DeleteContext: resourceDigitalOceanGlobalLoadBalancerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithProject(func(id string) string {
+				return godo.LoadBalancer{ID: id}.URN()
+			}),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"domain": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"is_managed": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "whether the domain is managed by DigitalOcean DNS",
+						},
+						"certificate_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"glb_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"http",
+								"https",
+							}, false),
+						},
+						"target_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"cdn_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "whether responses are cached at the edge",
+						},
+						"region_priorities": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "failover priority of each target region, lower values are preferred",
+						},
+						"failover_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+							Description:  "percentage of unhealthy targets in a region at which traffic fails over to the next region",
+						},
+					},
+				},
+			},
+
+			"target_load_balancer_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"droplet_ids"},
+			},
+
+			"droplet_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeInt},
+				ConflictsWith: []string{"target_load_balancer_ids"},
+			},
+
+			"healthcheck": resourceDigitalOceanLoadBalancerV0().Schema["healthcheck"],
+
+			"project_id": projectIDSchema(),
+
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the uniform resource name for the global load balancer",
+			},
+
+			"ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the anycast IP address of the global load balancer",
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func buildGlobalLoadBalancerRequest(d *schema.ResourceData) *loadBalancerRequest {
+	opts := &loadBalancerRequest{
+		LoadBalancerRequest: godo.LoadBalancerRequest{
+			Name: d.Get("name").(string),
+		},
+		Type:        loadBalancerTypeGlobal,
+		Domains:     expandLoadBalancerDomains(d.Get("domain").(*schema.Set).List()),
+		GLBSettings: expandGlobalLoadBalancerSettings(d.Get("glb_settings").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("target_load_balancer_ids"); ok {
+		for _, id := range v.(*schema.Set).List() {
+			opts.TargetLoadBalancerIDs = append(opts.TargetLoadBalancerIDs, id.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("droplet_ids"); ok {
+		var droplets []int
+		for _, id := range v.(*schema.Set).List() {
+			droplets = append(droplets, id.(int))
+		}
+
+		opts.DropletIDs = droplets
+	}
+
+	if v, ok := d.GetOk("healthcheck"); ok {
+		opts.HealthCheck = expandHealthCheck(v.([]interface{}))
+	}
+
+	return opts
+}
+
+func resourceDigitalOceanGlobalLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := buildGlobalLoadBalancerRequest(d)
+
+	log.Printf("[DEBUG] Global Load Balancer Create: %#v", opts)
+	lb, _, err := createLoadBalancer(context.Background(), client, opts)
+	if err != nil {
+		return diag.Errorf("Error creating Global Load Balancer: %s", err)
+	}
+
+	d.SetId(lb.ID)
+
+	log.Printf("[DEBUG] Waiting for Global Load Balancer (%s) to become active", d.Get("name"))
+	if err := waitForLoadBalancerActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error waiting for Global Load Balancer (%s) to become active: %s", d.Get("name"), err)
+	}
+
+	if _, ok := d.GetOk("project_id"); ok {
+		if err := assignToProject(client, d, lb.URN()); err != nil {
+			return diag.Errorf("Error assigning Global Load Balancer to project: %s", err)
+		}
+	}
+
+	return resourceDigitalOceanGlobalLoadBalancerRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanGlobalLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	lb, resp, err := getLoadBalancer(context.Background(), client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] DigitalOcean Global Load Balancer (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving Global Load Balancer: %s", err)
+	}
+
+	d.Set("name", lb.Name)
+	d.Set("urn", lb.URN())
+	d.Set("ip", lb.IP)
+	d.Set("status", lb.Status)
+	d.Set("created_at", lb.Created)
+
+	if err := d.Set("domain", flattenLoadBalancerDomains(lb.Domains)); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Global Load Balancer domain - error: %#v", err)
+	}
+
+	if err := d.Set("glb_settings", flattenGlobalLoadBalancerSettings(lb.GLBSettings)); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Global Load Balancer glb_settings - error: %#v", err)
+	}
+
+	if err := d.Set("target_load_balancer_ids", lb.TargetLoadBalancerIDs); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Global Load Balancer target_load_balancer_ids - error: %#v", err)
+	}
+
+	if err := d.Set("droplet_ids", flattenDropletIds(lb.DropletIDs)); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Global Load Balancer droplet_ids - error: %#v", err)
+	}
+
+	if err := d.Set("healthcheck", flattenHealthChecks(lb.HealthCheck)); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Global Load Balancer healthcheck - error: %#v", err)
+	}
+
+	if err := readProjectAssignment(meta, d, lb.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanGlobalLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := buildGlobalLoadBalancerRequest(d)
+
+	log.Printf("[DEBUG] Global Load Balancer Update: %#v", opts)
+	_, _, err := updateLoadBalancer(context.Background(), client, d.Id(), opts)
+	if err != nil {
+		return diag.Errorf("Error updating Global Load Balancer: %s", err)
+	}
+
+	if d.HasChange("project_id") {
+		if err := assignToProject(client, d, godo.LoadBalancer{ID: d.Id()}.URN()); err != nil {
+			return diag.Errorf("Error assigning Global Load Balancer to project: %s", err)
+		}
+	}
+
+	return resourceDigitalOceanGlobalLoadBalancerRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanGlobalLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	log.Printf("[INFO] Deleting Global Load Balancer: %s", d.Id())
+	_, err := client.LoadBalancers.Delete(context.Background(), d.Id())
+	if err != nil {
+		return diag.Errorf("Error deleting Global Load Balancer: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandLoadBalancerDomains(config []interface{}) []*loadBalancerDomain {
+	domains := make([]*loadBalancerDomain, 0, len(config))
+
+	for _, rawDomain := range config {
+		domain := rawDomain.(map[string]interface{})
+
+		domains = append(domains, &loadBalancerDomain{
+			Name:          domain["name"].(string),
+			IsManaged:     domain["is_managed"].(bool),
+			CertificateID: domain["certificate_id"].(string),
+		})
+	}
+
+	return domains
+}
+
+func flattenLoadBalancerDomains(domains []*loadBalancerDomain) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(domains))
+
+	for _, domain := range domains {
+		r := make(map[string]interface{})
+		r["name"] = domain.Name
+		r["is_managed"] = domain.IsManaged
+		r["certificate_id"] = domain.CertificateID
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func expandGlobalLoadBalancerSettings(config []interface{}) *globalLoadBalancerSettings {
+	settingsConfig := config[0].(map[string]interface{})
+
+	settings := &globalLoadBalancerSettings{
+		TargetProtocol:    settingsConfig["target_protocol"].(string),
+		TargetPort:        settingsConfig["target_port"].(int),
+		FailoverThreshold: settingsConfig["failover_threshold"].(int),
+		CDN: &globalLoadBalancerCDNSettings{
+			IsEnabled: settingsConfig["cdn_enabled"].(bool),
+		},
+	}
+
+	if v, ok := settingsConfig["region_priorities"]; ok {
+		priorities := v.(map[string]interface{})
+		if len(priorities) > 0 {
+			settings.RegionPriorities = make(map[string]int, len(priorities))
+			for region, priority := range priorities {
+				settings.RegionPriorities[region] = priority.(int)
+			}
+		}
+	}
+
+	return settings
+}
+
+func flattenGlobalLoadBalancerSettings(settings *globalLoadBalancerSettings) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, 1)
+
+	if settings != nil {
+		r := make(map[string]interface{})
+		r["target_protocol"] = settings.TargetProtocol
+		r["target_port"] = settings.TargetPort
+		r["failover_threshold"] = settings.FailoverThreshold
+		r["region_priorities"] = settings.RegionPriorities
+		r["cdn_enabled"] = settings.CDN != nil && settings.CDN.IsEnabled
+
+		result = append(result, r)
+	}
+
+	return result
+}
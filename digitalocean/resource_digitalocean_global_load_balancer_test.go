@@ -0,0 +1,185 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanGlobalLoadBalancer_Basic(t *testing.T) {
+	var lb loadBalancer
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanGlobalLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanGlobalLoadBalancerConfig_basic(rInt, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanGlobalLoadBalancerExists("digitalocean_global_load_balancer.foobar", &lb),
+					resource.TestCheckResourceAttr(
+						"digitalocean_global_load_balancer.foobar", "name", fmt.Sprintf("glb-%d", rInt)),
+					resource.TestCheckResourceAttr(
+						"digitalocean_global_load_balancer.foobar", "domain.#", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_global_load_balancer.foobar", "target_load_balancer_ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_global_load_balancer.foobar", "glb_settings.0.target_protocol", "http"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_global_load_balancer.foobar", "glb_settings.0.target_port", "80"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_global_load_balancer.foobar", "glb_settings.0.cdn_enabled", "false"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_global_load_balancer.foobar", "glb_settings.0.region_priorities.nyc3", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_global_load_balancer.foobar", "glb_settings.0.region_priorities.ams3", "2"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_global_load_balancer.foobar", "ip"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_global_load_balancer.foobar", "urn"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanGlobalLoadBalancerConfig_basic(rInt, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanGlobalLoadBalancerExists("digitalocean_global_load_balancer.foobar", &lb),
+					resource.TestCheckResourceAttr(
+						"digitalocean_global_load_balancer.foobar", "glb_settings.0.cdn_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_global_load_balancer.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanGlobalLoadBalancerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_global_load_balancer" {
+			continue
+		}
+
+		_, _, err := getLoadBalancer(context.Background(), client, rs.Primary.ID)
+
+		if err != nil && !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf(
+				"Error waiting for global load balancer (%s) to be destroyed: %s",
+				rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanGlobalLoadBalancerExists(n string, lb *loadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Global Load Balancer ID is set")
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		foundLB, _, err := getLoadBalancer(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundLB.ID != rs.Primary.ID {
+			return fmt.Errorf("Global Load Balancer not found")
+		}
+
+		if foundLB.Type != loadBalancerTypeGlobal {
+			return fmt.Errorf("Expected load balancer type %s, got: %s", loadBalancerTypeGlobal, foundLB.Type)
+		}
+
+		*lb = *foundLB
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanGlobalLoadBalancerConfig_basic(rInt int, cdnEnabled bool) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "nyc" {
+  name   = "foo-nyc-%[1]d"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-20-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet" "ams" {
+  name   = "foo-ams-%[1]d"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-20-04-x64"
+  region = "ams3"
+}
+
+resource "digitalocean_loadbalancer" "nyc" {
+  name   = "loadbalancer-nyc-%[1]d"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port      = 80
+    entry_protocol  = "http"
+    target_port     = 80
+    target_protocol = "http"
+  }
+
+  droplet_ids = [digitalocean_droplet.nyc.id]
+}
+
+resource "digitalocean_loadbalancer" "ams" {
+  name   = "loadbalancer-ams-%[1]d"
+  region = "ams3"
+
+  forwarding_rule {
+    entry_port      = 80
+    entry_protocol  = "http"
+    target_port     = 80
+    target_protocol = "http"
+  }
+
+  droplet_ids = [digitalocean_droplet.ams.id]
+}
+
+resource "digitalocean_global_load_balancer" "foobar" {
+  name = "glb-%[1]d"
+
+  domain {
+    name = "glb-%[1]d.example.com"
+  }
+
+  glb_settings {
+    target_protocol = "http"
+    target_port     = 80
+    cdn_enabled     = %[2]t
+
+    region_priorities = {
+      nyc3 = 1
+      ams3 = 2
+    }
+  }
+
+  target_load_balancer_ids = [
+    digitalocean_loadbalancer.nyc.id,
+    digitalocean_loadbalancer.ams.id,
+  ]
+}`, rInt, cdnEnabled)
+}
@@ -87,6 +87,8 @@ func resourceDigitalOceanKubernetesCluster() *schema.Resource {
 
 			"tags": tagsSchema(),
 
+			"project_id": projectIDSchema(),
+
 			"maintenance_policy": {
 				Type:     schema.TypeList,
 				MinItems: 1,
@@ -153,6 +155,7 @@ func resourceDigitalOceanKubernetesCluster() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -284,6 +287,12 @@ func resourceDigitalOceanKubernetesClusterCreate(ctx context.Context, d *schema.
 		return diag.Errorf("Error creating Kubernetes cluster: %s", err)
 	}
 
+	if _, ok := d.GetOk("project_id"); ok {
+		if err := assignToProject(client, d, cluster.URN()); err != nil {
+			return diag.Errorf("Error assigning Kubernetes cluster to project: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanKubernetesClusterRead(ctx, d, meta)
 }
 
@@ -300,6 +309,10 @@ func resourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.Re
 		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
 	}
 
+	if err := readProjectAssignment(meta, d, cluster.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return digitaloceanKubernetesClusterRead(client, cluster, d)
 }
 
@@ -409,6 +422,12 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 		}
 	}
 
+	if d.HasChange("project_id") {
+		if err := assignToProject(client, d, godo.KubernetesCluster{ID: d.Id()}.URN()); err != nil {
+			return diag.Errorf("Error assigning Kubernetes cluster to project: %s", err)
+		}
+	}
+
 	// Update the node pool if necessary
 	if !d.HasChange("node_pool") {
 		return resourceDigitalOceanKubernetesClusterRead(ctx, d, meta)
@@ -424,7 +443,7 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 	}
 
 	// update the existing default pool
-	timeout := d.Timeout(schema.TimeoutCreate)
+	timeout := d.Timeout(schema.TimeoutUpdate)
 	_, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, newPool, d.Id(), oldPool["id"].(string), digitaloceanKubernetesDefaultNodePoolTag)
 	if err != nil {
 		return diag.FromErr(err)
@@ -523,6 +542,10 @@ func resourceDigitalOceanKubernetesClusterImportState(d *schema.ResourceData, me
 		}
 	}
 
+	if err := importProjectAssignment(meta, d, cluster.URN()); err != nil {
+		return nil, err
+	}
+
 	// Generate a list of ResourceData for the cluster and node pools.
 	resourceDatas := make([]*schema.ResourceData, 1)
 	resourceDatas[0] = d // the cluster
@@ -21,7 +21,9 @@ func resourceDigitalOceanLoadbalancer() *schema.Resource {
 		UpdateContext: resourceDigitalOceanLoadbalancerUpdate,
 		DeleteContext: resourceDigitalOceanLoadbalancerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithProject(func(id string) string {
+				return godo.LoadBalancer{ID: id}.URN()
+			}),
 		},
 
 		SchemaVersion: 1,
@@ -35,6 +37,10 @@ func resourceDigitalOceanLoadbalancer() *schema.Resource {
 
 		Schema: resourceDigitalOceanLoadBalancerV1(),
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 
 			if _, hasHealthCheck := diff.GetOk("healthcheck"); hasHealthCheck {
@@ -56,6 +62,24 @@ func resourceDigitalOceanLoadbalancer() *schema.Resource {
 				}
 			}
 
+			for _, rawRule := range diff.Get("forwarding_rule").(*schema.Set).List() {
+				rule := rawRule.(map[string]interface{})
+
+				if rule["entry_protocol"].(string) != "http3" {
+					continue
+				}
+
+				if rule["tls_passthrough"].(bool) {
+					return fmt.Errorf("forwarding rule `tls_passthrough` is not allowed for when entry_protocol is `http3`")
+				}
+
+				certName, _ := rule["certificate_name"].(string)
+				certID, _ := rule["certificate_id"].(string)
+				if certName == "" && certID == "" {
+					return fmt.Errorf("forwarding rule `certificate_name` is required for when entry_protocol is `http3`")
+				}
+			}
+
 			if _, hasStickySession := diff.GetOk("sticky_sessions.#"); hasStickySession {
 
 				sessionType := diff.Get("sticky_sessions.0.type").(string)
@@ -125,12 +149,21 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 			"size": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "lb-small",
+				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"lb-small",
 					"lb-medium",
 					"lb-large",
 				}, false),
+				ConflictsWith: []string{"size_unit"},
+			},
+			"size_unit": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IntBetween(1, 100),
+				ConflictsWith: []string{"size"},
+				Description:   "the number of nodes in the load balancer",
 			},
 
 			"name": {
@@ -166,6 +199,7 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 								"http",
 								"https",
 								"http2",
+								"http3",
 								"tcp",
 							}, false),
 						},
@@ -321,6 +355,14 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 				Default:  false,
 			},
 
+			"http_idle_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(30, 600),
+				Description:  "the number of seconds an idle HTTP connection is kept open before being closed",
+			},
+
 			"vpc_uuid": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -329,6 +371,42 @@ func resourceDigitalOceanLoadBalancerV0() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 
+			"network": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  loadBalancerNetworkExternal,
+				ValidateFunc: validation.StringInSlice([]string{
+					loadBalancerNetworkExternal,
+					loadBalancerNetworkInternal,
+				}, false),
+				Description: "whether the load balancer has a public IP (EXTERNAL) or is only reachable within its VPC (INTERNAL)",
+			},
+
+			"firewall": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateLoadBalancerFirewallRule},
+							Description: "the rules for allowing traffic to the load balancer, e.g. cidr:1.2.0.0/16",
+						},
+						"deny": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateLoadBalancerFirewallRule},
+							Description: "the rules for denying traffic to the load balancer, e.g. ip:1.2.3.4",
+						},
+					},
+				},
+			},
+
+			"project_id": projectIDSchema(),
+
 			"ip": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -371,21 +449,39 @@ func migrateLoadBalancerStateV0toV1(ctx context.Context, rawState map[string]int
 	return rawState, nil
 }
 
-func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*godo.LoadBalancerRequest, error) {
+func buildLoadBalancerRequest(client *godo.Client, d *schema.ResourceData) (*loadBalancerRequest, error) {
 	forwardingRules, err := expandForwardingRules(client, d.Get("forwarding_rule").(*schema.Set).List())
 	if err != nil {
 		return nil, err
 	}
 
-	opts := &godo.LoadBalancerRequest{
-		Name:                   d.Get("name").(string),
-		SizeSlug:               d.Get("size").(string),
-		Region:                 d.Get("region").(string),
-		Algorithm:              d.Get("algorithm").(string),
-		RedirectHttpToHttps:    d.Get("redirect_http_to_https").(bool),
-		EnableProxyProtocol:    d.Get("enable_proxy_protocol").(bool),
-		EnableBackendKeepalive: d.Get("enable_backend_keepalive").(bool),
-		ForwardingRules:        forwardingRules,
+	opts := &loadBalancerRequest{
+		LoadBalancerRequest: godo.LoadBalancerRequest{
+			Name:                   d.Get("name").(string),
+			Region:                 d.Get("region").(string),
+			Algorithm:              d.Get("algorithm").(string),
+			RedirectHttpToHttps:    d.Get("redirect_http_to_https").(bool),
+			EnableProxyProtocol:    d.Get("enable_proxy_protocol").(bool),
+			EnableBackendKeepalive: d.Get("enable_backend_keepalive").(bool),
+			ForwardingRules:        forwardingRules,
+		},
+		Firewall:        expandLoadBalancerFirewall(d.Get("firewall").([]interface{})),
+		Network:         d.Get("network").(string),
+		HTTPIdleTimeout: d.Get("http_idle_timeout_seconds").(int),
+	}
+
+	// Load balancers are sized either by a legacy size slug or by their
+	// number of nodes, but the API only accepts one of the two. Only one of
+	// them can be configured and the other is read back from the API, so a
+	// set size_unit wins unless size was just changed.
+	size := d.Get("size").(string)
+	if v, ok := d.GetOk("size_unit"); ok && !(d.HasChange("size") && size != "") {
+		opts.SizeUnit = v.(int)
+	} else {
+		if size == "" {
+			size = "lb-small"
+		}
+		opts.SizeSlug = size
 	}
 
 	if v, ok := d.GetOk("droplet_tag"); ok {
@@ -425,7 +521,7 @@ func resourceDigitalOceanLoadbalancerCreate(ctx context.Context, d *schema.Resou
 	}
 
 	log.Printf("[DEBUG] Loadbalancer Create: %#v", lbOpts)
-	loadbalancer, _, err := client.LoadBalancers.Create(context.Background(), lbOpts)
+	loadbalancer, _, err := createLoadBalancer(context.Background(), client, lbOpts)
 	if err != nil {
 		return diag.Errorf("Error creating Load Balancer: %s", err)
 	}
@@ -433,17 +529,16 @@ func resourceDigitalOceanLoadbalancerCreate(ctx context.Context, d *schema.Resou
 	d.SetId(loadbalancer.ID)
 
 	log.Printf("[DEBUG] Waiting for Load Balancer (%s) to become active", d.Get("name"))
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"new"},
-		Target:     []string{"active"},
-		Refresh:    loadbalancerStateRefreshFunc(client, d.Id()),
-		Timeout:    10 * time.Minute,
-		MinTimeout: 15 * time.Second,
-	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if err := waitForLoadBalancerActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("Error waiting for Load Balancer (%s) to become active: %s", d.Get("name"), err)
 	}
 
+	if _, ok := d.GetOk("project_id"); ok {
+		if err := assignToProject(client, d, loadbalancer.URN()); err != nil {
+			return diag.Errorf("Error assigning Load Balancer to project: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanLoadbalancerRead(ctx, d, meta)
 }
 
@@ -451,7 +546,7 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 	client := meta.(*CombinedConfig).godoClient()
 
 	log.Printf("[INFO] Reading the details of the Loadbalancer %s", d.Id())
-	loadbalancer, resp, err := client.LoadBalancers.Get(context.Background(), d.Id())
+	loadbalancer, resp, err := getLoadBalancer(context.Background(), client, d.Id())
 	if err != nil {
 		if resp != nil && resp.StatusCode == 404 {
 			log.Printf("[WARN] DigitalOcean Load Balancer (%s) not found", d.Id())
@@ -470,9 +565,19 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 	d.Set("redirect_http_to_https", loadbalancer.RedirectHttpToHttps)
 	d.Set("enable_proxy_protocol", loadbalancer.EnableProxyProtocol)
 	d.Set("enable_backend_keepalive", loadbalancer.EnableBackendKeepalive)
+	d.Set("http_idle_timeout_seconds", loadbalancer.HTTPIdleTimeout)
 	d.Set("droplet_tag", loadbalancer.Tag)
 	d.Set("vpc_uuid", loadbalancer.VPCUUID)
 	d.Set("size", loadbalancer.SizeSlug)
+	d.Set("size_unit", loadbalancer.SizeUnit)
+
+	// Load balancers created before the network type was introduced do
+	// not report one and are always external.
+	if loadbalancer.Network != "" {
+		d.Set("network", loadbalancer.Network)
+	} else {
+		d.Set("network", loadBalancerNetworkExternal)
+	}
 
 	if err := d.Set("droplet_ids", flattenDropletIds(loadbalancer.DropletIDs)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting Load Balancer droplet_ids - error: %#v", err)
@@ -495,6 +600,14 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("[DEBUG] Error setting Load Balancer forwarding_rule - error: %#v", err)
 	}
 
+	if err := d.Set("firewall", flattenLoadBalancerFirewall(loadbalancer.Firewall)); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Load Balancer firewall - error: %#v", err)
+	}
+
+	if err := readProjectAssignment(meta, d, loadbalancer.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 
 }
@@ -508,11 +621,17 @@ func resourceDigitalOceanLoadbalancerUpdate(ctx context.Context, d *schema.Resou
 	}
 
 	log.Printf("[DEBUG] Load Balancer Update: %#v", lbOpts)
-	_, _, err = client.LoadBalancers.Update(context.Background(), d.Id(), lbOpts)
+	_, _, err = updateLoadBalancer(context.Background(), client, d.Id(), lbOpts)
 	if err != nil {
 		return diag.Errorf("Error updating Load Balancer: %s", err)
 	}
 
+	if d.HasChange("project_id") {
+		if err := assignToProject(client, d, godo.LoadBalancer{ID: d.Id()}.URN()); err != nil {
+			return diag.Errorf("Error assigning Load Balancer to project: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanLoadbalancerRead(ctx, d, meta)
 }
 
@@ -529,3 +648,16 @@ func resourceDigitalOceanLoadbalancerDelete(ctx context.Context, d *schema.Resou
 	return nil
 
 }
+
+func waitForLoadBalancerActive(ctx context.Context, client *godo.Client, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"new"},
+		Target:     []string{"active"},
+		Refresh:    loadbalancerStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -98,6 +99,8 @@ func TestAccDigitalOceanLoadbalancer_Basic(t *testing.T) {
 						"digitalocean_loadbalancer.foobar", "enable_proxy_protocol", "true"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_loadbalancer.foobar", "enable_backend_keepalive", "true"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "http_idle_timeout_seconds", "90"),
 				),
 			},
 		},
@@ -148,6 +151,8 @@ func TestAccDigitalOceanLoadbalancer_Updated(t *testing.T) {
 						"digitalocean_loadbalancer.foobar", "enable_proxy_protocol", "true"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_loadbalancer.foobar", "enable_backend_keepalive", "true"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "http_idle_timeout_seconds", "90"),
 				),
 			},
 			{
@@ -185,6 +190,8 @@ func TestAccDigitalOceanLoadbalancer_Updated(t *testing.T) {
 						"digitalocean_loadbalancer.foobar", "enable_proxy_protocol", "false"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_loadbalancer.foobar", "enable_backend_keepalive", "false"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "http_idle_timeout_seconds", "600"),
 				),
 			},
 		},
@@ -435,6 +442,46 @@ func TestAccDigitalOceanLoadbalancer_sslCertByName(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanLoadbalancer_http3(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	lbName := randomTestName()
+	certName := randomTestName()
+	privateKeyMaterial, leafCertMaterial, certChainMaterial := generateTestCertMaterial(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDigitalOceanLoadbalancerConfig_http3NoCert(lbName),
+				ExpectError: regexp.MustCompile("forwarding rule `certificate_name` is required for when entry_protocol is `http3`"),
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_http3(
+					certName, lbName, privateKeyMaterial, leafCertMaterial, certChainMaterial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "forwarding_rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_loadbalancer.foobar",
+						"forwarding_rule.*",
+						map[string]string{
+							"entry_port":       "443",
+							"entry_protocol":   "http3",
+							"target_port":      "80",
+							"target_protocol":  "http",
+							"certificate_name": certName,
+							"tls_passthrough":  "false",
+						},
+					),
+				),
+			},
+		},
+	})
+}
+
 // Load balancers can only be resized once an hour. The initial create counts
 // as a "resize" in this context. This test can not perform a resize, but it
 // does ensure that the the PUT includes the expected content by checking for
@@ -557,6 +604,241 @@ func TestAccDigitalOceanLoadbalancer_WithVPC(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanLoadbalancer_SizeUnit(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	var resized godo.LoadBalancer
+	lbName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_SizeUnit(lbName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "size_unit", "1"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_SizeUnit(lbName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &resized),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "size_unit", "2"),
+					func(s *terraform.State) error {
+						if loadbalancer.ID != resized.ID || loadbalancer.IP != resized.IP {
+							return fmt.Errorf("Expected Load Balancer to be resized in place, was replaced")
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanLoadbalancer_ProxyProtocol(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	lbName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_ProxyProtocol(lbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					testAccCheckDigitalOceanLoadbalancerProxyProtocol(&loadbalancer, true),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "enable_proxy_protocol", "true"),
+				),
+			},
+			{
+				// Disable PROXY protocol outside of Terraform to ensure the drift is detected.
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+					req := loadbalancer.AsRequest()
+					req.EnableProxyProtocol = false
+					if _, _, err := client.LoadBalancers.Update(context.Background(), loadbalancer.ID, req); err != nil {
+						t.Fatalf("Error disabling PROXY protocol on Load Balancer: %s", err)
+					}
+				},
+				Config:             testAccCheckDigitalOceanLoadbalancerConfig_ProxyProtocol(lbName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_ProxyProtocol(lbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					testAccCheckDigitalOceanLoadbalancerProxyProtocol(&loadbalancer, true),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "enable_proxy_protocol", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildLoadBalancerRequest_Size(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "lb-1",
+		Attributes: map[string]string{
+			"id":        "lb-1",
+			"name":      "lb-1",
+			"region":    "nyc3",
+			"size":      "lb-small",
+			"size_unit": "2",
+		},
+	}
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		sizeSlug string
+		sizeUnit int
+	}{
+		{
+			name:     "unrelated change",
+			config:   map[string]interface{}{"name": "lb-2", "region": "nyc3", "size_unit": 2},
+			sizeUnit: 2,
+		},
+		{
+			name:     "size_unit changed",
+			config:   map[string]interface{}{"name": "lb-1", "region": "nyc3", "size_unit": 3},
+			sizeUnit: 3,
+		},
+		{
+			name:     "size changed",
+			config:   map[string]interface{}{"name": "lb-1", "region": "nyc3", "size": "lb-medium"},
+			sizeSlug: "lb-medium",
+		},
+	}
+
+	r := resourceDigitalOceanLoadbalancer()
+	for _, c := range cases {
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(c.config), nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+
+		d, err := schema.InternalMap(r.Schema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+
+		req, err := buildLoadBalancerRequest(nil, d)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+		if req.SizeSlug != c.sizeSlug || req.SizeUnit != c.sizeUnit {
+			t.Errorf("%s: expected size %q and size_unit %d, got %q and %d", c.name, c.sizeSlug, c.sizeUnit, req.SizeSlug, req.SizeUnit)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "lb-1", "region": "nyc3"})
+	req, err := buildLoadBalancerRequest(nil, d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if req.SizeSlug != "lb-small" || req.SizeUnit != 0 {
+		t.Errorf("expected new load balancers to default to lb-small, got %q and %d", req.SizeSlug, req.SizeUnit)
+	}
+}
+
+func TestAccDigitalOceanLoadbalancer_InternalNetwork(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	lbName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_InternalNetwork(lbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "network", "INTERNAL"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_loadbalancer.foobar", "vpc_uuid"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanLoadbalancer_Firewall(t *testing.T) {
+	var loadbalancer godo.LoadBalancer
+	lbName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanLoadbalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_Firewall(lbName, `
+  firewall {
+    allow = ["cidr:1.2.0.0/16"]
+    deny  = ["ip:1.2.3.4"]
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "firewall.#", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "firewall.0.allow.#", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "firewall.0.allow.0", "cidr:1.2.0.0/16"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "firewall.0.deny.#", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "firewall.0.deny.0", "ip:1.2.3.4"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_Firewall(lbName, `
+  firewall {
+    allow = ["cidr:1.2.0.0/16", "cidr:10.0.0.0/8"]
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "firewall.0.allow.#", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "firewall.0.deny.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanLoadbalancerConfig_Firewall(lbName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanLoadbalancerExists("digitalocean_loadbalancer.foobar", &loadbalancer),
+					resource.TestCheckResourceAttr(
+						"digitalocean_loadbalancer.foobar", "firewall.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanLoadbalancerProxyProtocol(loadbalancer *godo.LoadBalancer, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if loadbalancer.EnableProxyProtocol != expected {
+			return fmt.Errorf("Expected enable_proxy_protocol to be %t, got: %t", expected, loadbalancer.EnableProxyProtocol)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDigitalOceanLoadbalancerDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
 
@@ -632,8 +914,9 @@ resource "digitalocean_loadbalancer" "foobar" {
     protocol = "tcp"
   }
 
-  enable_proxy_protocol    = true
-  enable_backend_keepalive = true
+  enable_proxy_protocol     = true
+  enable_backend_keepalive  = true
+  http_idle_timeout_seconds = 90
 
   droplet_ids = [digitalocean_droplet.foobar.id]
 }`, rInt, rInt)
@@ -672,8 +955,9 @@ resource "digitalocean_loadbalancer" "foobar" {
     protocol = "tcp"
   }
 
-  enable_proxy_protocol    = false
-  enable_backend_keepalive = false
+  enable_proxy_protocol     = false
+  enable_backend_keepalive  = false
+  http_idle_timeout_seconds = 600
 
   droplet_ids = [digitalocean_droplet.foobar.id, digitalocean_droplet.foo.id]
 }`, rInt, rInt, rInt)
@@ -866,3 +1150,130 @@ resource "digitalocean_loadbalancer" "foobar" {
   droplet_ids = [digitalocean_droplet.foobar.id]
 }`, randomTestName(), randomTestName(), name)
 }
+
+func testAccCheckDigitalOceanLoadbalancerConfig_Firewall(name string, firewall string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port      = 80
+    entry_protocol  = "http"
+    target_port     = 80
+    target_protocol = "http"
+  }
+%s
+}`, name, firewall)
+}
+
+func testAccCheckDigitalOceanLoadbalancerConfig_InternalNetwork(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_vpc" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name     = "%s"
+  region   = "nyc3"
+  network  = "INTERNAL"
+  vpc_uuid = digitalocean_vpc.foobar.id
+
+  forwarding_rule {
+    entry_port      = 80
+    entry_protocol  = "http"
+    target_port     = 80
+    target_protocol = "http"
+  }
+}`, randomTestName(), name)
+}
+
+func testAccCheckDigitalOceanLoadbalancerConfig_SizeUnit(name string, sizeUnit int) string {
+	return fmt.Sprintf(`
+resource "digitalocean_loadbalancer" "foobar" {
+  name      = "%s"
+  region    = "nyc3"
+  size_unit = %d
+
+  forwarding_rule {
+    entry_port      = 80
+    entry_protocol  = "http"
+    target_port     = 80
+    target_protocol = "http"
+  }
+}`, name, sizeUnit)
+}
+
+func testAccCheckDigitalOceanLoadbalancerConfig_http3NoCert(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port      = 443
+    entry_protocol  = "http3"
+    target_port     = 80
+    target_protocol = "http"
+  }
+}`, name)
+}
+
+func testAccCheckDigitalOceanLoadbalancerConfig_http3(certName string, name string, privateKeyMaterial, leafCert, certChain string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_certificate" "foobar" {
+  name = "%s"
+  private_key = <<EOF
+%s
+EOF
+  leaf_certificate = <<EOF
+%s
+EOF
+  certificate_chain = <<EOF
+%s
+EOF
+}
+
+resource "digitalocean_loadbalancer" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+
+  forwarding_rule {
+    entry_port       = 443
+    entry_protocol   = "https"
+    target_port      = 80
+    target_protocol  = "http"
+    certificate_name = digitalocean_certificate.foobar.name
+  }
+
+  forwarding_rule {
+    entry_port       = 443
+    entry_protocol   = "http3"
+    target_port      = 80
+    target_protocol  = "http"
+    certificate_name = digitalocean_certificate.foobar.name
+  }
+}`, certName, privateKeyMaterial, leafCert, certChain, name)
+}
+
+func testAccCheckDigitalOceanLoadbalancerConfig_ProxyProtocol(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_loadbalancer" "foobar" {
+  name                  = "%s"
+  region                = "nyc3"
+  enable_proxy_protocol = true
+
+  forwarding_rule {
+    entry_port      = 5432
+    entry_protocol  = "tcp"
+    target_port     = 5432
+    target_protocol = "tcp"
+  }
+
+  healthcheck {
+    port     = 5432
+    protocol = "tcp"
+  }
+}`, name)
+}
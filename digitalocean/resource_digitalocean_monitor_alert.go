@@ -2,6 +2,7 @@ package digitalocean
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/digitalocean/godo"
@@ -10,6 +11,50 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Alert types about the metrics of managed database clusters, which are not
+// defined by godo yet. Their entities are database cluster IDs.
+const (
+	dbaasCPUUtilizationPercent    = "v1/dbaas/alerts/cpu_alerts"
+	dbaasMemoryUtilizationPercent = "v1/dbaas/alerts/memory_utilization_alerts"
+	dbaasDiskUtilizationPercent   = "v1/dbaas/alerts/disk_utilization_alerts"
+	dbaasFifteenMinuteLoadAverage = "v1/dbaas/alerts/load_15_alerts"
+)
+
+// Alert types about the metrics of load balancers, which are not defined by
+// godo yet. Their entities are load balancer IDs.
+const (
+	lbaasCPUUtilizationPercent                = "v1/insights/lbaas/avg_cpu_utilization_percent"
+	lbaasConnectionUtilizationPercent         = "v1/insights/lbaas/connection_utilization_percent"
+	lbaasTLSUtilizationPercent                = "v1/insights/lbaas/tls_connections_per_second_utilization_percent"
+	lbaasDropletHealth                        = "v1/insights/lbaas/droplet_health"
+	lbaasIncreaseInHTTPErrorRatePercentage5xx = "v1/insights/lbaas/increase_in_http_error_rate_percentage_5xx"
+	lbaasIncreaseInHTTPErrorRatePercentage4xx = "v1/insights/lbaas/increase_in_http_error_rate_percentage_4xx"
+	lbaasIncreaseInHTTPErrorRateCount5xx      = "v1/insights/lbaas/increase_in_http_error_rate_count_5xx"
+	lbaasIncreaseInHTTPErrorRateCount4xx      = "v1/insights/lbaas/increase_in_http_error_rate_count_4xx"
+	lbaasHighHTTPResponseTime                 = "v1/insights/lbaas/high_http_request_response_time"
+	lbaasHighHTTPResponseTime50P              = "v1/insights/lbaas/high_http_request_response_time_50p"
+	lbaasHighHTTPResponseTime95P              = "v1/insights/lbaas/high_http_request_response_time_95p"
+	lbaasHighHTTPResponseTime99P              = "v1/insights/lbaas/high_http_request_response_time_99p"
+)
+
+// monitorAlertPercentageTypes are the alert types whose value is a
+// percentage. The other metrics are rates, durations, counts or load averages
+// without an upper bound.
+var monitorAlertPercentageTypes = map[string]bool{
+	godo.DropletCPUUtilizationPercent:         true,
+	godo.DropletMemoryUtilizationPercent:      true,
+	godo.DropletDiskUtilizationPercent:        true,
+	dbaasCPUUtilizationPercent:                true,
+	dbaasMemoryUtilizationPercent:             true,
+	dbaasDiskUtilizationPercent:               true,
+	lbaasCPUUtilizationPercent:                true,
+	lbaasConnectionUtilizationPercent:         true,
+	lbaasTLSUtilizationPercent:                true,
+	lbaasDropletHealth:                        true,
+	lbaasIncreaseInHTTPErrorRatePercentage5xx: true,
+	lbaasIncreaseInHTTPErrorRatePercentage4xx: true,
+}
+
 func resourceDigitalOceanMonitorAlert() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanMonitorAlertCreate,
@@ -42,6 +87,22 @@ func resourceDigitalOceanMonitorAlert() *schema.Resource {
 					godo.DropletOneMinuteLoadAverage,
 					godo.DropletFiveMinuteLoadAverage,
 					godo.DropletFifteenMinuteLoadAverage,
+					dbaasCPUUtilizationPercent,
+					dbaasMemoryUtilizationPercent,
+					dbaasDiskUtilizationPercent,
+					dbaasFifteenMinuteLoadAverage,
+					lbaasCPUUtilizationPercent,
+					lbaasConnectionUtilizationPercent,
+					lbaasTLSUtilizationPercent,
+					lbaasDropletHealth,
+					lbaasIncreaseInHTTPErrorRatePercentage5xx,
+					lbaasIncreaseInHTTPErrorRatePercentage4xx,
+					lbaasIncreaseInHTTPErrorRateCount5xx,
+					lbaasIncreaseInHTTPErrorRateCount4xx,
+					lbaasHighHTTPResponseTime,
+					lbaasHighHTTPResponseTime50P,
+					lbaasHighHTTPResponseTime95P,
+					lbaasHighHTTPResponseTime99P,
 				}, false),
 			},
 
@@ -120,7 +181,7 @@ func resourceDigitalOceanMonitorAlert() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				MinItems:    1,
-				Description: "The droplets to apply the alert policy to",
+				Description: "The droplets, database clusters or load balancers to apply the alert policy to",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -134,6 +195,15 @@ func resourceDigitalOceanMonitorAlert() *schema.Resource {
 				}, false),
 			},
 		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			alertType := diff.Get("type").(string)
+			if monitorAlertPercentageTypes[alertType] && diff.NewValueKnown("value") && diff.Get("value").(float64) > 100 {
+				return fmt.Errorf("value must be a percentage between 0 and 100 for %s alerts", alertType)
+			}
+
+			return nil
+		},
 	}
 }
 
@@ -3,12 +3,50 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"log"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("digitalocean_monitor_alert", &resource.Sweeper{
+		Name: "digitalocean_monitor_alert",
+		F:    testSweepMonitorAlerts,
+	})
+}
+
+func testSweepMonitorAlerts(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*CombinedConfig).godoClient()
+
+	opt := &godo.ListOptions{PerPage: 200}
+	alerts, _, err := client.Monitoring.ListAlertPolicies(context.Background(), opt)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range alerts {
+		if strings.HasPrefix(a.Description, "Alert about ") || strings.HasPrefix(a.Description, testNamePrefix) {
+			log.Printf("Destroying monitor alert %s", a.Description)
+
+			if _, err := client.Monitoring.DeleteAlertPolicy(context.Background(), a.UUID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 const (
 	slackChannels = `
 slack {
@@ -131,6 +169,69 @@ resource "digitalocean_monitor_alert" "%s" {
 	description = "%s"
   }
 `
+
+	testAccAlertPolicyDatabaseCluster = `
+resource "digitalocean_database_cluster" "foobar" {
+	name       = "%s"
+	engine     = "pg"
+	version    = "11"
+	size       = "db-s-1vcpu-1gb"
+	region     = "nyc1"
+	node_count = 1
+  }
+
+  resource "digitalocean_monitor_alert" "%s" {
+	alerts {
+	  email = ["benny@digitalocean.com"]
+	}
+	window      = "5m"
+	type        = "%s"
+	compare     = "GreaterThan"
+	value       = 90
+	entities    = [digitalocean_database_cluster.foobar.id]
+	description = "Alert about database usage"
+  }
+`
+
+	testAccAlertPolicyLoadBalancer = `
+resource "digitalocean_loadbalancer" "foobar" {
+	name   = "%s"
+	region = "nyc3"
+
+	forwarding_rule {
+	  entry_port     = 80
+	  entry_protocol = "http"
+
+	  target_port     = 80
+	  target_protocol = "http"
+	}
+  }
+
+  resource "digitalocean_monitor_alert" "%s" {
+	alerts {
+	  email = ["benny@digitalocean.com"]
+	}
+	window      = "5m"
+	type        = "%s"
+	compare     = "GreaterThan"
+	value       = %d
+	entities    = [digitalocean_loadbalancer.foobar.id]
+	description = "Alert about load balancer health"
+  }
+`
+
+	testAccAlertPolicyValue = `
+resource "digitalocean_monitor_alert" "%s" {
+	alerts  {
+	  email 	= ["benny@digitalocean.com"]
+	}
+	window      = "5m"
+	type        = "%s"
+	compare     = "GreaterThan"
+	value       = %d
+	description = "Alert about usage"
+  }
+`
 )
 
 func TestAccDigitalOceanMonitorAlert(t *testing.T) {
@@ -225,6 +326,130 @@ func TestAccDigitalOceanMonitorAlertUpdate(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanMonitorAlertMetricTypes(t *testing.T) {
+	var randName = randomTestName()
+	resourceName := fmt.Sprintf("digitalocean_monitor_alert.%s", randName)
+
+	metricTypes := []string{
+		"v1/insights/droplet/disk_utilization_percent",
+		"v1/insights/droplet/disk_read",
+		"v1/insights/droplet/disk_write",
+		"v1/insights/droplet/load_1",
+		"v1/insights/droplet/load_5",
+		"v1/insights/droplet/load_15",
+		"v1/insights/droplet/public_outbound_bandwidth",
+		"v1/insights/droplet/public_inbound_bandwidth",
+		"v1/insights/droplet/private_outbound_bandwidth",
+		"v1/insights/droplet/private_inbound_bandwidth",
+	}
+
+	steps := make([]resource.TestStep, 0, len(metricTypes))
+	for _, metricType := range metricTypes {
+		steps = append(steps, resource.TestStep{
+			Config: fmt.Sprintf(testAccAlertPolicy, randName, "", "5m", metricType, "Alert about "+metricType),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceName, "type", metricType),
+				resource.TestCheckResourceAttr(resourceName, "description", "Alert about "+metricType),
+			),
+		})
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		ProviderFactories:         testAccProviderFactories,
+		CheckDestroy:              testAccCheckDigitalOceanMonitorAlertDestroy,
+		PreventPostDestroyRefresh: true,
+		Steps:                     steps,
+	})
+}
+
+func TestAccDigitalOceanMonitorAlertPercentageValue(t *testing.T) {
+	var randName = randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanMonitorAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccAlertPolicyValue, randName, "v1/insights/droplet/memory_utilization_percent", 150),
+				ExpectError: regexp.MustCompile("value must be a percentage between 0 and 100"),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanMonitorAlertDatabaseCluster(t *testing.T) {
+	var (
+		randName     = randomTestName()
+		databaseName = randomTestName()
+	)
+	resourceName := fmt.Sprintf("digitalocean_monitor_alert.%s", randName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		ProviderFactories:         testAccProviderFactories,
+		CheckDestroy:              testAccCheckDigitalOceanMonitorAlertDestroy,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccAlertPolicyDatabaseCluster, databaseName, randName, "v1/dbaas/alerts/cpu_alerts"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "v1/dbaas/alerts/cpu_alerts"),
+					resource.TestCheckResourceAttr(resourceName, "entities.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "entities.*", "digitalocean_database_cluster.foobar", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccAlertPolicyDatabaseCluster, databaseName, randName, "v1/dbaas/alerts/load_15_alerts"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "v1/dbaas/alerts/load_15_alerts"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanMonitorAlertLoadBalancer(t *testing.T) {
+	var (
+		randName = randomTestName()
+		lbName   = randomTestName()
+	)
+	resourceName := fmt.Sprintf("digitalocean_monitor_alert.%s", randName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		ProviderFactories:         testAccProviderFactories,
+		CheckDestroy:              testAccCheckDigitalOceanMonitorAlertDestroy,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccAlertPolicyLoadBalancer, lbName, randName, "v1/insights/lbaas/increase_in_http_error_rate_percentage_5xx", 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "v1/insights/lbaas/increase_in_http_error_rate_percentage_5xx"),
+					resource.TestCheckResourceAttr(resourceName, "value", "5"),
+					resource.TestCheckResourceAttr(resourceName, "entities.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "entities.*", "digitalocean_loadbalancer.foobar", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccAlertPolicyLoadBalancer, lbName, randName, "v1/insights/lbaas/connection_utilization_percent", 80),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "v1/insights/lbaas/connection_utilization_percent"),
+					resource.TestCheckResourceAttr(resourceName, "value", "80"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccAlertPolicyLoadBalancer, lbName, randName, "v1/insights/lbaas/high_http_request_response_time_99p", 500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "v1/insights/lbaas/high_http_request_response_time_99p"),
+					resource.TestCheckResourceAttr(resourceName, "value", "500"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanMonitorAlertWithTag(t *testing.T) {
 	var (
 		randName = randomTestName()
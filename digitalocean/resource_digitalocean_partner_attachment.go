@@ -0,0 +1,327 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanPartnerAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanPartnerAttachmentCreate,
+		ReadContext:   resourceDigitalOceanPartnerAttachmentRead,
+		UpdateContext: resourceDigitalOceanPartnerAttachmentUpdate,
+		DeleteContext: resourceDigitalOceanPartnerAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"connection_bandwidth_in_mbps": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{1000, 2000, 5000, 10000}),
+				Description:  "the bandwidth of the connection in Mbps",
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					// DO API V2 region slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+			},
+			"naas_provider": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the name of the network as a service provider, e.g. MEGAPORT",
+			},
+			"vpc_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the VPCs to connect to the partner network",
+			},
+			"bgp": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"local_asn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "the ASN of the DigitalOcean side of the BGP session",
+						},
+						"local_router_ip": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsCIDR,
+							Description:  "the IP address, in CIDR notation, of the DigitalOcean side of the BGP session",
+						},
+						"peer_asn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateBGPASN,
+							Description:  "the ASN of the partner side of the BGP session",
+						},
+						"peer_router_ip": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsCIDR,
+							Description:  "the IP address, in CIDR notation, of the partner side of the BGP session",
+						},
+						"auth_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Sensitive:   true,
+							Description: "the key used to authenticate the BGP session",
+						},
+					},
+				},
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the current state of the attachment",
+			},
+			"service_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "the key to provide to the partner to complete the connection",
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func expandPartnerAttachmentVPCIDs(config []interface{}) []string {
+	vpcIDs := make([]string, 0, len(config))
+	for _, id := range config {
+		vpcIDs = append(vpcIDs, id.(string))
+	}
+
+	return vpcIDs
+}
+
+func expandPartnerAttachmentBGP(config []interface{}) *partnerAttachmentBGP {
+	if len(config) == 0 || config[0] == nil {
+		return nil
+	}
+
+	bgpConfig := config[0].(map[string]interface{})
+	peerASN, _ := strconv.ParseUint(bgpConfig["peer_asn"].(string), 10, 32)
+
+	return &partnerAttachmentBGP{
+		LocalRouterIP: bgpConfig["local_router_ip"].(string),
+		PeerASN:       uint32(peerASN),
+		PeerRouterIP:  bgpConfig["peer_router_ip"].(string),
+		AuthKey:       bgpConfig["auth_key"].(string),
+	}
+}
+
+func flattenPartnerAttachmentBGP(bgp *partnerAttachmentBGP, authKey string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, 1)
+
+	if bgp != nil {
+		r := make(map[string]interface{})
+		r["local_asn"] = flattenBGPASN(bgp.LocalASN)
+		r["local_router_ip"] = bgp.LocalRouterIP
+		r["peer_asn"] = flattenBGPASN(bgp.PeerASN)
+		r["peer_router_ip"] = bgp.PeerRouterIP
+		// The API does not return the auth key once set.
+		r["auth_key"] = authKey
+
+		result = append(result, r)
+	}
+
+	return result
+}
+
+// flattenBGPASN formats an ASN for the schema, leaving it empty when unset.
+func flattenBGPASN(asn uint32) string {
+	if asn == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(asn), 10)
+}
+
+// validateBGPASN checks that an ASN is in the 32-bit range of RFC 6793. ASNs
+// are strings in the schema as 4-byte ASNs overflow TypeInt on 32-bit platforms.
+func validateBGPASN(v interface{}, k string) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if asn, err := strconv.ParseUint(value, 10, 32); err != nil || asn < 1 {
+		return nil, []error{fmt.Errorf("expected %s to be an integer in the range (1 - %d), got %s", k, uint32(math.MaxUint32), value)}
+	}
+
+	return nil, nil
+}
+
+func resourceDigitalOceanPartnerAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := &partnerAttachmentCreateRequest{
+		Name:                      d.Get("name").(string),
+		ConnectionBandwidthInMbps: d.Get("connection_bandwidth_in_mbps").(int),
+		Region:                    strings.ToLower(d.Get("region").(string)),
+		NaaSProvider:              d.Get("naas_provider").(string),
+		VPCIDs:                    expandPartnerAttachmentVPCIDs(d.Get("vpc_ids").(*schema.Set).List()),
+		BGP:                       expandPartnerAttachmentBGP(d.Get("bgp").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Partner Attachment create configuration: %#v", opts)
+	attachment, _, err := createPartnerAttachment(context.Background(), client, opts)
+	if err != nil {
+		return diag.Errorf("Error creating Partner Attachment: %s", err)
+	}
+
+	d.SetId(attachment.ID)
+	log.Printf("[INFO] Partner Attachment created, ID: %s", d.Id())
+
+	return resourceDigitalOceanPartnerAttachmentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanPartnerAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	attachment, resp, err := getPartnerAttachment(context.Background(), client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Partner Attachment (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Partner Attachment: %s", err)
+	}
+
+	d.Set("name", attachment.Name)
+	d.Set("connection_bandwidth_in_mbps", attachment.ConnectionBandwidthInMbps)
+	d.Set("region", attachment.Region)
+	d.Set("naas_provider", attachment.NaaSProvider)
+	d.Set("state", attachment.State)
+	d.Set("created_at", attachment.CreatedAt)
+
+	if err := d.Set("vpc_ids", attachment.VPCIDs); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Partner Attachment vpc_ids - error: %#v", err)
+	}
+
+	if err := d.Set("bgp", flattenPartnerAttachmentBGP(attachment.BGP, d.Get("bgp.0.auth_key").(string))); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Partner Attachment bgp - error: %#v", err)
+	}
+
+	// The service key is generated shortly after the attachment is created.
+	serviceKey, resp, err := getPartnerAttachmentServiceKey(context.Background(), client, d.Id())
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return diag.Errorf("Error retrieving Partner Attachment service key: %s", err)
+	}
+
+	if serviceKey != nil {
+		d.Set("service_key", serviceKey.Value)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanPartnerAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := &partnerAttachmentUpdateRequest{}
+
+	if d.HasChange("name") {
+		opts.Name = d.Get("name").(string)
+	}
+
+	if d.HasChange("vpc_ids") {
+		opts.VPCIDs = expandPartnerAttachmentVPCIDs(d.Get("vpc_ids").(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Partner Attachment update configuration: %#v", opts)
+	_, _, err := updatePartnerAttachment(context.Background(), client, d.Id(), opts)
+	if err != nil {
+		return diag.Errorf("Error updating Partner Attachment: %s", err)
+	}
+
+	return resourceDigitalOceanPartnerAttachmentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanPartnerAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	log.Printf("[INFO] Deleting Partner Attachment: %s", d.Id())
+	resp, err := deletePartnerAttachment(context.Background(), client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+
+		return diag.Errorf("Error deleting Partner Attachment: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Partner Attachment (%s) to be deleted", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			attachment, resp, err := getPartnerAttachment(context.Background(), client, d.Id())
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					return d.Id(), "DELETED", nil
+				}
+
+				return nil, "", fmt.Errorf("Error retrieving Partner Attachment (%s): %s", d.Id(), err)
+			}
+
+			return attachment, "DELETING", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for Partner Attachment (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,158 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanPartnerAttachment_Basic(t *testing.T) {
+	var attachment partnerAttachment
+	vpcName := randomTestName()
+	attachmentName := randomTestName()
+	updatedName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanPartnerAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanPartnerAttachmentConfig_basic(vpcName, attachmentName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanPartnerAttachmentExists("digitalocean_partner_attachment.foobar", &attachment),
+					resource.TestCheckResourceAttr(
+						"digitalocean_partner_attachment.foobar", "name", attachmentName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_partner_attachment.foobar", "connection_bandwidth_in_mbps", "1000"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_partner_attachment.foobar", "region", "nyc"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_partner_attachment.foobar", "naas_provider", "MEGAPORT"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_partner_attachment.foobar", "vpc_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_partner_attachment.foobar", "bgp.0.local_router_ip", "169.254.0.1/29"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_partner_attachment.foobar", "bgp.0.peer_asn", "133937"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_partner_attachment.foobar", "bgp.0.peer_router_ip", "169.254.0.6/29"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_partner_attachment.foobar", "state"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_partner_attachment.foobar", "created_at"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanPartnerAttachmentConfig_basic(vpcName, updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanPartnerAttachmentExists("digitalocean_partner_attachment.foobar", &attachment),
+					resource.TestCheckResourceAttr(
+						"digitalocean_partner_attachment.foobar", "name", updatedName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanPartnerAttachmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_partner_attachment" {
+			continue
+		}
+
+		_, _, err := getPartnerAttachment(context.Background(), client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Partner Attachment still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanPartnerAttachmentExists(n string, attachment *partnerAttachment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Partner Attachment ID is set")
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		foundAttachment, _, err := getPartnerAttachment(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundAttachment.ID != rs.Primary.ID {
+			return fmt.Errorf("Partner Attachment not found")
+		}
+
+		*attachment = *foundAttachment
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanPartnerAttachmentConfig_basic(vpcName string, name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_vpc" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+}
+
+resource "digitalocean_partner_attachment" "foobar" {
+  name                         = "%s"
+  connection_bandwidth_in_mbps = 1000
+  region                       = "nyc"
+  naas_provider                = "MEGAPORT"
+  vpc_ids                      = [digitalocean_vpc.foobar.id]
+
+  bgp {
+    local_router_ip = "169.254.0.1/29"
+    peer_asn        = 133937
+    peer_router_ip  = "169.254.0.6/29"
+    auth_key        = "BGPAu7hK3y!"
+  }
+}
+`, vpcName, name)
+}
+
+func TestValidateBGPASN(t *testing.T) {
+	valid := []string{"1", "64512", "133937", "2147483648", "4294967295"}
+	invalid := []string{"-1", "0", "4294967296", "as133937", ""}
+
+	for _, asn := range valid {
+		if _, errs := validateBGPASN(asn, "peer_asn"); len(errs) != 0 {
+			t.Errorf("expected %s to be valid, got %v", asn, errs)
+		}
+	}
+	for _, asn := range invalid {
+		if _, errs := validateBGPASN(asn, "peer_asn"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", asn)
+		}
+	}
+
+	bgp := expandPartnerAttachmentBGP([]interface{}{map[string]interface{}{
+		"local_router_ip": "",
+		"peer_asn":        "4294967295",
+		"peer_router_ip":  "",
+		"auth_key":        "",
+	}})
+	if bgp.PeerASN != math.MaxUint32 {
+		t.Errorf("expected peer_asn to be expanded to %d, got %d", uint32(math.MaxUint32), bgp.PeerASN)
+	}
+	if asn := flattenPartnerAttachmentBGP(bgp, "")[0]["peer_asn"]; asn != "4294967295" {
+		t.Errorf("expected peer_asn to be flattened to 4294967295, got %v", asn)
+	}
+}
@@ -44,14 +44,14 @@ func resourceDigitalOceanProject() *schema.Resource {
 				Optional:     true,
 				Default:      "Web Application",
 				Description:  "the purpose of the project",
-				ValidateFunc: validation.StringLenBetween(0, 255),
+				ValidateFunc: validateProjectPurpose,
 			},
 			"environment": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "Development",
 				Description:  "the environment of the project's resources",
-				ValidateFunc: validation.StringInSlice([]string{"development", "staging", "production"}, true),
+				ValidateFunc: validation.StringInSlice(projectEnvironments, true),
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return strings.ToLower(old) == strings.ToLower(new)
 				},
@@ -67,8 +67,10 @@ func resourceDigitalOceanProject() *schema.Resource {
 				Description: "the id of the project owner.",
 			},
 			"is_default": {
-				Type:     schema.TypeBool,
-				Computed: true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "whether resources not assigned to a project are added to this project",
 			},
 			"created_at": {
 				Type:        schema.TypeString,
@@ -127,6 +129,17 @@ func resourceDigitalOceanProjectCreate(ctx context.Context, d *schema.ResourceDa
 	d.SetId(project.ID)
 	log.Printf("[INFO] Project created, ID: %s", d.Id())
 
+	// Projects are never created as the default one, it has to be claimed
+	// afterwards.
+	if d.Get("is_default").(bool) {
+		_, _, err := client.Projects.Update(context.Background(), project.ID, &godo.UpdateProjectRequest{
+			IsDefault: true,
+		})
+		if err != nil {
+			return diag.Errorf("Error setting Project %s as the default project: %s", project.ID, err)
+		}
+	}
+
 	return resourceDigitalOceanProjectRead(ctx, d, meta)
 }
 
@@ -191,6 +204,20 @@ func resourceDigitalOceanProjectUpdate(ctx context.Context, d *schema.ResourceDa
 
 	d.Partial(true)
 
+	// The default flag moves to a project when it is claimed, it can not be
+	// removed from the default project. Releasing it only succeeds once
+	// another project has claimed it.
+	if d.HasChange("is_default") && !d.Get("is_default").(bool) {
+		project, _, err := client.Projects.Get(context.Background(), projectId)
+		if err != nil {
+			return diag.Errorf("Error reading Project: %s", err)
+		}
+
+		if project.IsDefault {
+			return diag.Errorf("Error updating Project: %s is the default project, set is_default on another project to make it the default one instead", projectId)
+		}
+	}
+
 	projectRequest := &godo.UpdateProjectRequest{
 		Name:        d.Get("name"),
 		Description: d.Get("description"),
@@ -238,6 +265,10 @@ func resourceDigitalOceanProjectDelete(ctx context.Context, d *schema.ResourceDa
 
 	projectId := d.Id()
 
+	if d.Get("is_default").(bool) {
+		return diag.Errorf("Error deleting Project: %s is the default project, set is_default on another project before deleting it", projectId)
+	}
+
 	if v, ok := d.GetOk("resources"); ok {
 
 		_, err := assignResourcesToDefaultProject(client, v.(*schema.Set))
@@ -297,30 +328,9 @@ func assignResourcesToProject(client *godo.Client, projectId string, resources *
 }
 
 func loadResourceURNs(client *godo.Client, projectId string) (*[]string, error) {
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
-	}
-
-	resourceList := []godo.ProjectResource{}
-	for {
-		resources, resp, err := client.Projects.ListResources(context.Background(), projectId, opts)
-		if err != nil {
-			return nil, fmt.Errorf("Error loading project resources: %s", err)
-		}
-
-		resourceList = append(resourceList, resources...)
-
-		if resp.Links == nil || resp.Links.IsLastPage() {
-			break
-		}
-
-		page, err := resp.Links.CurrentPage()
-		if err != nil {
-			return nil, fmt.Errorf("Error loading project resources: %s", err)
-		}
-
-		opts.Page = page + 1
+	resourceList, err := listProjectResources(client, projectId)
+	if err != nil {
+		return nil, err
 	}
 
 	var urns []string
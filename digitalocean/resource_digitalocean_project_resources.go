@@ -2,6 +2,7 @@ package digitalocean
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,9 @@ func resourceDigitalOceanProjectResources() *schema.Resource {
 		UpdateContext: resourceDigitalOceanProjectResourcesUpdate,
 		ReadContext:   resourceDigitalOceanProjectResourcesRead,
 		DeleteContext: resourceDigitalOceanProjectResourcesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanProjectResourcesImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project": {
@@ -148,3 +152,19 @@ func resourceDigitalOceanProjectResourcesDelete(ctx context.Context, d *schema.R
 	d.SetId("")
 	return nil
 }
+
+// resourceDigitalOceanProjectResourcesImport imports all of the resources of
+// the project, Read only keeps the configured ones afterwards.
+func resourceDigitalOceanProjectResourcesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	urns, err := loadResourceURNs(client, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error while retrieving project resources: %s", err)
+	}
+
+	d.Set("project", d.Id())
+	d.Set("resources", *urns)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -3,13 +3,59 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
+	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("digitalocean_project", &resource.Sweeper{
+		Name: "digitalocean_project",
+		F:    testSweepProjects,
+		Dependencies: []string{
+			"digitalocean_droplet",
+			"digitalocean_domain",
+			"digitalocean_spaces_bucket",
+		},
+	})
+}
+
+func testSweepProjects(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*CombinedConfig).godoClient()
+
+	opt := &godo.ListOptions{PerPage: 200}
+	projects, _, err := client.Projects.List(context.Background(), opt)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range projects {
+		if p.IsDefault {
+			continue
+		}
+
+		if strings.HasPrefix(p.Name, "tf-proj-test-") || strings.HasPrefix(p.Name, "tf-acc-project-") || strings.HasPrefix(p.Name, testNamePrefix) {
+			log.Printf("Destroying project %s", p.Name)
+
+			if _, err := client.Projects.Delete(context.Background(), p.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func TestAccDigitalOceanProject_CreateWithDefaults(t *testing.T) {
 
 	expectedName := generateProjectName()
@@ -279,6 +325,87 @@ func TestAccDigitalOceanProject_WithManyResources(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanProject_IsDefault(t *testing.T) {
+	var originalDefaultID string
+	projectName := generateProjectName()
+
+	// The default project is shared by the whole account, so the test does
+	// not run in parallel and hands the flag back to the original default
+	// project before releasing it.
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fixtureCreateWithIsDefault(projectName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanProjectExists("digitalocean_project.myproj"),
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "is_default", "false"),
+					func(s *terraform.State) error {
+						client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+						defaultProject, _, err := client.Projects.GetDefault(context.Background())
+						if err != nil {
+							return fmt.Errorf("Error locating default project: %s", err)
+						}
+						originalDefaultID = defaultProject.ID
+
+						return nil
+					},
+				),
+			},
+			{
+				Config: fixtureCreateWithIsDefault(projectName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "is_default", "true"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+					_, _, err := client.Projects.Update(context.Background(), originalDefaultID, &godo.UpdateProjectRequest{
+						IsDefault: true,
+					})
+					if err != nil {
+						t.Fatalf("Error restoring default project %s: %s", originalDefaultID, err)
+					}
+				},
+				Config: fixtureCreateWithIsDefault(projectName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_project.myproj", "is_default", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateProjectPurpose(t *testing.T) {
+	cases := []struct {
+		purpose     string
+		expectError bool
+	}{
+		{purpose: "Web Application"},
+		{purpose: "IoT"},
+		{purpose: "My Basic Web App"},
+		{purpose: ""},
+		{purpose: "web application", expectError: true},
+		{purpose: "IOT", expectError: true},
+		{purpose: strings.Repeat("a", 256), expectError: true},
+	}
+
+	for _, c := range cases {
+		_, errors := validateProjectPurpose(c.purpose, "purpose")
+		if c.expectError && len(errors) == 0 {
+			t.Errorf("expected an error for purpose %q", c.purpose)
+		}
+		if !c.expectError && len(errors) > 0 {
+			t.Errorf("unexpected errors for purpose %q: %v", c.purpose, errors)
+		}
+	}
+}
+
 func testAccCheckDigitalOceanProjectResourceURNIsPresent(resource, expectedURN string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
@@ -389,6 +516,14 @@ func fixtureCreateWithInitialValues(name, description, purpose, environment stri
 		}`, name, description, purpose, environment)
 }
 
+func fixtureCreateWithIsDefault(name string, isDefault bool) string {
+	return fmt.Sprintf(`
+		resource "digitalocean_project" "myproj" {
+			name       = "%s"
+			is_default = %t
+		}`, name, isDefault)
+}
+
 func fixtureCreateWithDropletResource(dropletName, name string) string {
 	return fmt.Sprintf(`
 		resource "digitalocean_droplet" "foobar" {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"strconv"
 	"strings"
 
@@ -13,6 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// txtRecordMaxStringLength is the length limit of a single character string
+// of a TXT record.
+const txtRecordMaxStringLength = 255
+
 func resourceDigitalOceanRecord() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDigitalOceanRecordCreate,
@@ -77,10 +82,11 @@ func resourceDigitalOceanRecord() *schema.Resource {
 			},
 
 			"ttl": {
-				Type:         schema.TypeInt,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: validation.IntAtLeast(1),
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IntAtLeast(1),
+				DiffSuppressFunc: suppressNormalizedTTLDiff,
 			},
 
 			"value": {
@@ -126,7 +132,11 @@ func resourceDigitalOceanRecord() *schema.Resource {
 			}
 
 			_, hasWeight := diff.GetOkExists("weight")
+			_, hasPort := diff.GetOkExists("port")
 			if recordType == "SRV" {
+				if !hasPort {
+					return fmt.Errorf("`port` is required for when type is `SRV`")
+				}
 				if !hasPriority {
 					return fmt.Errorf("`priority` is required for when type is `SRV`")
 				}
@@ -146,7 +156,7 @@ func resourceDigitalOceanRecord() *schema.Resource {
 				}
 			}
 
-			return nil
+			return validateDigitalOceanRecord(recordType, diff.Get("name").(string), diff.Get("value").(string), diff.Get("tag").(string))
 		},
 	}
 }
@@ -161,11 +171,6 @@ func resourceDigitalOceanRecordCreate(ctx context.Context, d *schema.ResourceDat
 
 	newRecord.Type = d.Get("type").(string)
 
-	_, hasPort := d.GetOkExists("port")
-	if newRecord.Type == "SRV" && !hasPort {
-		return diag.Errorf("`port` is required for when type is `SRV`")
-	}
-
 	log.Printf("[DEBUG] record create configuration: %#v", newRecord)
 	rec, _, err := client.Domains.CreateRecord(context.Background(), d.Get("domain").(string), newRecord)
 	if err != nil {
@@ -224,6 +229,23 @@ func resourceDigitalOceanRecordRead(ctx context.Context, d *schema.ResourceData,
 func resourceDigitalOceanRecordImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	if strings.Contains(d.Id(), ",") {
 		s := strings.Split(d.Id(), ",")
+
+		// The record can also be identified by its name and type, e.g.
+		// example.com,www,CNAME, in which case its ID is looked up.
+		if len(s) == 3 {
+			client := meta.(*CombinedConfig).godoClient()
+
+			id, err := findDigitalOceanRecordID(client, s[0], s[1], strings.ToUpper(s[2]))
+			if err != nil {
+				return nil, err
+			}
+
+			d.SetId(strconv.Itoa(id))
+			d.Set("domain", s[0])
+
+			return []*schema.ResourceData{d}, nil
+		}
+
 		// Validate that this is an ID by making sure it can be converted into an int
 		_, err := strconv.Atoi(s[1])
 		if err != nil {
@@ -237,6 +259,30 @@ func resourceDigitalOceanRecordImport(d *schema.ResourceData, meta interface{})
 	return []*schema.ResourceData{d}, nil
 }
 
+// findDigitalOceanRecordID returns the ID of the only record of a domain with
+// the given name and type.
+func findDigitalOceanRecordID(client *godo.Client, domain, name, recordType string) (int, error) {
+	records, _, err := listDigitalOceanRecordSet(client, domain, recordType, name)
+	if err != nil {
+		return 0, fmt.Errorf("Error retrieving records: %s", err)
+	}
+
+	switch len(records) {
+	case 0:
+		return 0, fmt.Errorf("no %s record named %s found in domain %s", recordType, name, domain)
+	case 1:
+		return records[0].ID, nil
+	}
+
+	ids := make([]string, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, fmt.Sprintf("%s,%d (%s)", domain, record.ID, record.Data))
+	}
+
+	return 0, fmt.Errorf("%d %s records named %s found in domain %s, import one of them by ID: %s",
+		len(records), recordType, name, domain, strings.Join(ids, ", "))
+}
+
 func resourceDigitalOceanRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*CombinedConfig).godoClient()
 
@@ -313,6 +359,68 @@ func expandDigitalOceanRecordResource(d *schema.ResourceData) (*godo.DomainRecor
 	return record, nil
 }
 
+// validateDigitalOceanRecord checks the format of the name and value of a
+// record, which would otherwise only be rejected by the API. Values which are
+// not known yet are empty and skipped.
+func validateDigitalOceanRecord(recordType, name, value, tag string) error {
+	switch recordType {
+	case "SRV":
+		labels := strings.Split(name, ".")
+		if name != "" && (len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_")) {
+			return fmt.Errorf("`name` of SRV records must start with the service and protocol, e.g. `_sip._tcp`: %s", name)
+		}
+	case "MX":
+		if net.ParseIP(value) != nil {
+			return fmt.Errorf("`value` of MX records must be a hostname, not an IP address: %s", value)
+		}
+	case "CAA":
+		if tag == "iodef" && value != "" && !strings.HasPrefix(value, "mailto:") &&
+			!strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
+			return fmt.Errorf("`value` of CAA records with the `iodef` tag must be a mailto:, http:// or https:// URL: %s", value)
+		}
+	case "TXT":
+		if err := validateTXTRecordValue(value); err != nil {
+			return fmt.Errorf("invalid `value` for TXT record: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// validateTXTRecordValue checks values split in quoted strings, e.g. long DKIM
+// keys, for which each string is limited to 255 characters.
+func validateTXTRecordValue(value string) error {
+	if !strings.HasPrefix(value, "\"") {
+		return nil
+	}
+
+	rest := value
+	for rest != "" {
+		if rest[0] != '"' {
+			return fmt.Errorf("expected a quoted string at %q", rest)
+		}
+
+		end := 1
+		for end < len(rest) && rest[end] != '"' {
+			if rest[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(rest) {
+			return fmt.Errorf("unterminated quoted string")
+		}
+
+		if end-1 > txtRecordMaxStringLength {
+			return fmt.Errorf("quoted strings are limited to %d characters, found %d", txtRecordMaxStringLength, end-1)
+		}
+
+		rest = strings.TrimLeft(rest[end+1:], " ")
+	}
+
+	return nil
+}
+
 func constructFqdn(name, domain string) string {
 	if name == "@" {
 		return domain
@@ -0,0 +1,370 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanRecordSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanRecordSetCreate,
+		ReadContext:   resourceDigitalOceanRecordSetRead,
+		UpdateContext: resourceDigitalOceanRecordSetUpdate,
+		DeleteContext: resourceDigitalOceanRecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanRecordSetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"A",
+					"AAAA",
+					"CAA",
+					"MX",
+					"NS",
+					"TXT",
+					"SRV",
+				}, false),
+			},
+
+			"ttl": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IntAtLeast(1),
+				DiffSuppressFunc: suppressNormalizedTTLDiff,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"flags": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+						"tag": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"issue",
+								"issuewild",
+								"iodef",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			recordType := diff.Get("type").(string)
+
+			for _, raw := range diff.Get("record").(*schema.Set).List() {
+				record := raw.(map[string]interface{})
+				value := record["value"].(string)
+
+				// Values are not known yet when they reference other resources.
+				if value == "" {
+					continue
+				}
+
+				if recordType == "CAA" && record["tag"].(string) == "" {
+					return fmt.Errorf("`tag` is required for when type is `CAA`")
+				}
+
+				if err := validateDigitalOceanRecord(recordType, diff.Get("name").(string), value, record["tag"].(string)); err != nil {
+					return err
+				}
+
+				// The API returns host names without their trailing dot, require
+				// it so that the records can be matched against the configuration.
+				needsDot := recordType == "MX" || recordType == "NS" || recordType == "SRV" ||
+					(recordType == "CAA" && record["tag"].(string) != "iodef")
+				if needsDot && value != "@" && !strings.HasSuffix(value, ".") {
+					return fmt.Errorf("`value` of %s records must be a fully qualified domain name ending with a dot: %s", recordType, value)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceDigitalOceanRecordSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	domain := d.Get("domain").(string)
+	recordType := d.Get("type").(string)
+	name := d.Get("name").(string)
+
+	if err := syncDigitalOceanRecordSet(client, d); err != nil {
+		return diag.Errorf("Error creating record set: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s,%s", domain, recordType, name))
+	log.Printf("[INFO] Record set ID: %s", d.Id())
+
+	return resourceDigitalOceanRecordSetRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanRecordSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+
+	records, resp, err := listDigitalOceanRecordSet(client, domain, d.Get("type").(string), name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Domain (%s) not found", domain)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving record set: %s", err)
+	}
+
+	if len(records) == 0 {
+		log.Printf("[WARN] Record set (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	flattened := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		flattened = append(flattened, flattenDigitalOceanRecordSetRecord(record))
+	}
+
+	if err := d.Set("record", flattened); err != nil {
+		return diag.Errorf("[DEBUG] Error setting record - error: %#v", err)
+	}
+	d.Set("ttl", records[0].TTL)
+	d.Set("fqdn", constructFqdn(name, domain))
+
+	return nil
+}
+
+func resourceDigitalOceanRecordSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	if d.HasChanges("record", "ttl") {
+		if err := syncDigitalOceanRecordSet(client, d); err != nil {
+			return diag.Errorf("Error updating record set (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanRecordSetRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanRecordSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	domain := d.Get("domain").(string)
+
+	records, resp, err := listDigitalOceanRecordSet(client, domain, d.Get("type").(string), d.Get("name").(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving record set: %s", err)
+	}
+
+	for _, record := range records {
+		if err := deleteDigitalOceanRecord(client, domain, record.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanRecordSetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != 3 || s[0] == "" || s[1] == "" || s[2] == "" {
+		return nil, fmt.Errorf("invalid record set ID, expected <domain>,<type>,<name>: %s", d.Id())
+	}
+
+	d.Set("domain", s[0])
+	d.Set("type", s[1])
+	d.Set("name", s[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// syncDigitalOceanRecordSet makes the records of the set's name and type
+// match the configuration: records which are still wanted are kept, the
+// others are deleted and the missing ones are created.
+func syncDigitalOceanRecordSet(client *godo.Client, d *schema.ResourceData) error {
+	domain := d.Get("domain").(string)
+	recordType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	ttl := d.Get("ttl").(int)
+	if ttl != 0 {
+		ttl = normalizeRecordTTL(ttl)
+	}
+
+	desired := make(map[string]*godo.DomainRecordEditRequest)
+	for _, raw := range d.Get("record").(*schema.Set).List() {
+		record := expandDigitalOceanRecordSetRecord(raw.(map[string]interface{}))
+		record.Type = recordType
+		record.Name = name
+		record.TTL = ttl
+		desired[recordSetKey(record)] = record
+	}
+
+	existing, _, err := listDigitalOceanRecordSet(client, domain, recordType, name)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range existing {
+		flattened := flattenDigitalOceanRecordSetRecord(record)
+		key := recordSetKey(expandDigitalOceanRecordSetRecord(flattened))
+
+		want, ok := desired[key]
+		if !ok {
+			if err := deleteDigitalOceanRecord(client, domain, record.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if ttl != 0 && record.TTL != ttl {
+			log.Printf("[DEBUG] Updating TTL of record %d to %d", record.ID, ttl)
+			if _, _, err := client.Domains.EditRecord(context.Background(), domain, record.ID, want); err != nil {
+				return fmt.Errorf("Failed to update record: %s", err)
+			}
+		}
+		delete(desired, key)
+	}
+
+	for _, record := range desired {
+		log.Printf("[DEBUG] record create configuration: %#v", record)
+		if _, _, err := client.Domains.CreateRecord(context.Background(), domain, record); err != nil {
+			return fmt.Errorf("Failed to create record: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// listDigitalOceanRecordSet returns all the records of a domain with the given
+// type and name.
+func listDigitalOceanRecordSet(client *godo.Client, domain, recordType, name string) ([]godo.DomainRecord, *godo.Response, error) {
+	var result []godo.DomainRecord
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		records, resp, err := client.Domains.RecordsByTypeAndName(context.Background(), domain, recordType, constructFqdn(name, domain), opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		result = append(result, records...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			return result, resp, nil
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, resp, err
+		}
+
+		opts.Page = page + 1
+	}
+}
+
+func recordSetKey(record *godo.DomainRecordEditRequest) string {
+	return fmt.Sprintf("%s/%d/%d/%d/%d/%s", record.Data, record.Priority, record.Port, record.Weight, record.Flags, record.Tag)
+}
+
+func flattenDigitalOceanRecordSetRecord(record godo.DomainRecord) map[string]interface{} {
+	value := record.Data
+	if t := record.Type; t == "MX" || t == "NS" || t == "SRV" || t == "CAA" {
+		if value != "@" && record.Tag != "iodef" {
+			value += "."
+		}
+	}
+
+	return map[string]interface{}{
+		"value":    value,
+		"priority": record.Priority,
+		"port":     record.Port,
+		"weight":   record.Weight,
+		"flags":    record.Flags,
+		"tag":      record.Tag,
+	}
+}
+
+func expandDigitalOceanRecordSetRecord(record map[string]interface{}) *godo.DomainRecordEditRequest {
+	return &godo.DomainRecordEditRequest{
+		Data:     record["value"].(string),
+		Priority: record["priority"].(int),
+		Port:     record["port"].(int),
+		Weight:   record["weight"].(int),
+		Flags:    record["flags"].(int),
+		Tag:      record["tag"].(string),
+	}
+}
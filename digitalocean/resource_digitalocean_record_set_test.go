@@ -0,0 +1,184 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanRecordSet_Basic(t *testing.T) {
+	domain := fmt.Sprintf("foobar-test-terraform-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanRecordSetConfig_a, domain, `
+  record {
+    value = "192.168.0.10"
+  }
+
+  record {
+    value = "192.168.0.11"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanRecordSetCount("digitalocean_record_set.foobar", 2),
+					resource.TestCheckResourceAttr(
+						"digitalocean_record_set.foobar", "record.#", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_record_set.foobar", "ttl", "1800"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_record_set.foobar", "fqdn", "www."+domain),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_record_set.foobar", "record.*", map[string]string{"value": "192.168.0.10"}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_record_set.foobar", "record.*", map[string]string{"value": "192.168.0.11"}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanRecordSetConfig_a, domain, `
+  record {
+    value = "192.168.0.11"
+  }
+
+  record {
+    value = "192.168.0.12"
+  }
+
+  record {
+    value = "192.168.0.13"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanRecordSetCount("digitalocean_record_set.foobar", 3),
+					resource.TestCheckResourceAttr(
+						"digitalocean_record_set.foobar", "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_record_set.foobar", "record.*", map[string]string{"value": "192.168.0.13"}),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_record_set.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s,A,www", domain),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanRecordSet_MX(t *testing.T) {
+	domain := fmt.Sprintf("foobar-test-terraform-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanRecordSetConfig_mx, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanRecordSetCount("digitalocean_record_set.foobar", 2),
+					resource.TestCheckResourceAttr(
+						"digitalocean_record_set.foobar", "ttl", "3600"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_record_set.foobar", "record.*", map[string]string{
+							"value":    "mx1.example.com.",
+							"priority": "10",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"digitalocean_record_set.foobar", "record.*", map[string]string{
+							"value":    "mx2.example.com.",
+							"priority": "20",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanRecordSetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_record_set" {
+			continue
+		}
+
+		records, _, err := listDigitalOceanRecordSet(client, rs.Primary.Attributes["domain"], rs.Primary.Attributes["type"], rs.Primary.Attributes["name"])
+		if err == nil && len(records) > 0 {
+			return fmt.Errorf("Record set still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanRecordSetCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record Set ID is set")
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		domain := rs.Primary.Attributes["domain"]
+		records, _, err := client.Domains.RecordsByTypeAndName(context.Background(), domain, rs.Primary.Attributes["type"],
+			constructFqdn(rs.Primary.Attributes["name"], domain), nil)
+		if err != nil {
+			return err
+		}
+
+		if len(records) != count {
+			return fmt.Errorf("Expected %d records, found %d", count, len(records))
+		}
+
+		return nil
+	}
+}
+
+const testAccCheckDigitalOceanRecordSetConfig_a = `
+resource "digitalocean_domain" "foobar" {
+  name = "%s"
+}
+
+resource "digitalocean_record_set" "foobar" {
+  domain = digitalocean_domain.foobar.name
+  type   = "A"
+  name   = "www"
+%s
+}`
+
+const testAccCheckDigitalOceanRecordSetConfig_mx = `
+resource "digitalocean_domain" "foobar" {
+  name = "%s"
+}
+
+resource "digitalocean_record_set" "foobar" {
+  domain = digitalocean_domain.foobar.name
+  type   = "MX"
+  name   = "@"
+  ttl    = 3600
+
+  record {
+    value    = "mx1.example.com."
+    priority = 10
+  }
+
+  record {
+    value    = "mx2.example.com."
+    priority = 20
+  }
+}`
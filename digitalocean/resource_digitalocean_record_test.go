@@ -37,6 +37,61 @@ func TestDigitalOceanRecordConstructFqdn(t *testing.T) {
 	}
 }
 
+func TestDigitalOceanRecordValidate(t *testing.T) {
+	cases := []struct {
+		Type, Name, Value, Tag string
+		Valid                  bool
+	}{
+		{"SRV", "_sip._tcp", "sip.example.com.", "", true},
+		{"SRV", "_sip._tcp.example.com.", "sip.example.com.", "", true},
+		{"SRV", "sip", "sip.example.com.", "", false},
+		{"SRV", "_sip.tcp", "sip.example.com.", "", false},
+		{"MX", "@", "mail.example.com.", "", true},
+		{"MX", "@", "192.168.0.10", "", false},
+		{"CAA", "@", "letsencrypt.org.", "issue", true},
+		{"CAA", "@", "mailto:caa@example.com", "iodef", true},
+		{"CAA", "@", "https://example.com/caa", "iodef", true},
+		{"CAA", "@", "caa@example.com", "iodef", false},
+		{"TXT", "@", "v=spf1 -all", "", true},
+		{"TXT", "@", `"v=DKIM1; k=rsa; " "p=MIIB"`, "", true},
+		{"TXT", "@", `"v=DKIM1; k=rsa; " p=MIIB`, "", false},
+		{"TXT", "@", `"v=DKIM1; k=rsa;`, "", false},
+		{"TXT", "@", `"` + strings.Repeat("a", 256) + `"`, "", false},
+		{"TXT", "@", `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 255) + `"`, "", true},
+	}
+
+	for _, tc := range cases {
+		err := validateDigitalOceanRecord(tc.Type, tc.Name, tc.Value, tc.Tag)
+		if tc.Valid && err != nil {
+			t.Fatalf("%s %s %s: unexpected error: %s", tc.Type, tc.Name, tc.Value, err)
+		}
+		if !tc.Valid && err == nil {
+			t.Fatalf("%s %s %s: expected an error", tc.Type, tc.Name, tc.Value)
+		}
+	}
+}
+
+func TestDigitalOceanRecordSuppressNormalizedTTLDiff(t *testing.T) {
+	cases := []struct {
+		Old, New string
+		Suppress bool
+	}{
+		{"30", "1", true},
+		{"30", "29", true},
+		{"30", "30", true},
+		{"60", "30", false},
+		{"1800", "3600", false},
+		{"", "1", false},
+	}
+
+	for _, tc := range cases {
+		actual := suppressNormalizedTTLDiff("ttl", tc.Old, tc.New, nil)
+		if actual != tc.Suppress {
+			t.Fatalf("old: %s, new: %s\nsuppress: %t", tc.Old, tc.New, actual)
+		}
+	}
+}
+
 func TestAccDigitalOceanRecord_Basic(t *testing.T) {
 	var record godo.DomainRecord
 	domain := fmt.Sprintf("foobar-test-terraform-%s.com", acctest.RandString(10))
@@ -641,6 +696,31 @@ func TestAccDigitalOceanRecord_ExpectedErrors(t *testing.T) {
   type  = "CAA"
   value = "letsencrypt.org."
   flags   = 1
+}`
+		srvInvalidName = `resource "digitalocean_record" "foo_record" {
+  domain = "example.com"
+
+  type     = "SRV"
+  name     = "sip"
+  port     = 5060
+  priority = 10
+  weight   = 0
+  value    = "sip.example.com."
+}`
+		mxIPAddress = `resource "digitalocean_record" "foo_record" {
+  domain = "example.com"
+
+  name     = "@"
+  type     = "MX"
+  priority = 10
+  value    = "192.168.0.10"
+}`
+		txtLongString = `resource "digitalocean_record" "foo_record" {
+  domain = "example.com"
+
+  name  = "@"
+  type  = "TXT"
+  value = "\"%s\""
 }`
 	)
 
@@ -673,6 +753,18 @@ func TestAccDigitalOceanRecord_ExpectedErrors(t *testing.T) {
 				Config:      caaNoTag,
 				ExpectError: regexp.MustCompile("`tag` is required for when type is `CAA`"),
 			},
+			{
+				Config:      srvInvalidName,
+				ExpectError: regexp.MustCompile("`name` of SRV records must start with the service and protocol"),
+			},
+			{
+				Config:      mxIPAddress,
+				ExpectError: regexp.MustCompile("`value` of MX records must be a hostname"),
+			},
+			{
+				Config:      fmt.Sprintf(txtLongString, strings.Repeat("a", 300)),
+				ExpectError: regexp.MustCompile("quoted strings are limited to 255 characters"),
+			},
 		},
 	})
 }
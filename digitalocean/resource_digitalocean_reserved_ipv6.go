@@ -0,0 +1,189 @@
+package digitalocean
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanReservedIPv6() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanReservedIPv6Create,
+		UpdateContext: resourceDigitalOceanReservedIPv6Update,
+		ReadContext:   resourceDigitalOceanReservedIPv6Read,
+		DeleteContext: resourceDigitalOceanReservedIPv6Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithProject(func(id string) string {
+				return reservedIPv6{IP: id}.URN()
+			}),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					// DO API V2 region slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "the uniform resource name for the reserved ipv6",
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func resourceDigitalOceanReservedIPv6Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	createRequest := &reservedIPv6CreateRequest{
+		RegionSlug: d.Get("region").(string),
+	}
+
+	log.Printf("[DEBUG] Reserved IPv6 Create: %#v", createRequest)
+	reservedIP, _, err := createReservedIPv6(context.Background(), client, createRequest)
+	if err != nil {
+		return diag.Errorf("Error creating Reserved IPv6: %s", err)
+	}
+
+	d.SetId(reservedIP.IP)
+
+	if _, ok := d.GetOk("project_id"); ok {
+		if err := assignToProject(client, d, reservedIP.URN()); err != nil {
+			return diag.Errorf("Error assigning Reserved IPv6 to project: %s", err)
+		}
+	}
+
+	if v, ok := d.GetOk("droplet_id"); ok {
+		log.Printf("[INFO] Assigning the Reserved IPv6 to the Droplet %d", v.(int))
+		action, _, err := assignReservedIPv6(context.Background(), client, d.Id(), v.(int))
+		if err != nil {
+			return diag.Errorf("Error assigning Reserved IPv6 (%s) to the droplet: %s", d.Id(), err)
+		}
+
+		if err := waitForReservedIPv6ActionCompleted(ctx, meta, d.Id(), action.ID); err != nil {
+			return diag.Errorf("Error waiting for Reserved IPv6 (%s) to be assigned: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDigitalOceanReservedIPv6Read(ctx, d, meta)
+}
+
+func resourceDigitalOceanReservedIPv6Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	if d.HasChange("droplet_id") {
+		if v, ok := d.GetOk("droplet_id"); ok {
+			log.Printf("[INFO] Assigning the Reserved IPv6 %s to the Droplet %d", d.Id(), v.(int))
+			action, _, err := assignReservedIPv6(context.Background(), client, d.Id(), v.(int))
+			if err != nil {
+				return diag.Errorf("Error assigning Reserved IPv6 (%s) to the droplet: %s", d.Id(), err)
+			}
+
+			if err := waitForReservedIPv6ActionCompleted(ctx, meta, d.Id(), action.ID); err != nil {
+				return diag.Errorf("Error waiting for Reserved IPv6 (%s) to be assigned: %s", d.Id(), err)
+			}
+		} else {
+			log.Printf("[INFO] Unassigning the Reserved IPv6 %s", d.Id())
+			action, _, err := unassignReservedIPv6(context.Background(), client, d.Id())
+			if err != nil {
+				return diag.Errorf("Error unassigning Reserved IPv6 (%s): %s", d.Id(), err)
+			}
+
+			if err := waitForReservedIPv6ActionCompleted(ctx, meta, d.Id(), action.ID); err != nil {
+				return diag.Errorf("Error waiting for Reserved IPv6 (%s) to be unassigned: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("project_id") {
+		if err := assignToProject(client, d, reservedIPv6{IP: d.Id()}.URN()); err != nil {
+			return diag.Errorf("Error assigning Reserved IPv6 to project: %s", err)
+		}
+	}
+
+	return resourceDigitalOceanReservedIPv6Read(ctx, d, meta)
+}
+
+func resourceDigitalOceanReservedIPv6Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	log.Printf("[INFO] Reading the details of the Reserved IPv6 %s", d.Id())
+	reservedIP, resp, err := getReservedIPv6(context.Background(), client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Reserved IPv6 (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Reserved IPv6: %s", err)
+	}
+
+	d.Set("region", reservedIP.RegionSlug)
+	d.Set("ip_address", reservedIP.IP)
+	d.Set("urn", reservedIP.URN())
+
+	// Assignments made with digitalocean_reserved_ipv6_assignment are not
+	// tracked here, so the Droplet is only read back when configured.
+	if _, ok := d.GetOk("droplet_id"); ok {
+		if reservedIP.Droplet != nil {
+			d.Set("droplet_id", reservedIP.Droplet.ID)
+		} else {
+			d.Set("droplet_id", 0)
+		}
+	}
+
+	if err := readProjectAssignment(meta, d, reservedIP.URN()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanReservedIPv6Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	if _, ok := d.GetOk("droplet_id"); ok {
+		log.Printf("[INFO] Unassigning the Reserved IPv6 from the Droplet")
+		action, resp, err := unassignReservedIPv6(context.Background(), client, d.Id())
+		if err != nil {
+			if resp == nil || resp.StatusCode != 422 {
+				return diag.Errorf("Error unassigning Reserved IPv6 (%s) from the droplet: %s", d.Id(), err)
+			}
+
+			log.Printf("[DEBUG] Couldn't unassign Reserved IPv6 (%s) from droplet, possibly out of sync: %s", d.Id(), err)
+		} else if err := waitForReservedIPv6ActionCompleted(ctx, meta, d.Id(), action.ID); err != nil {
+			return diag.Errorf("Error waiting for Reserved IPv6 (%s) to be unassigned: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting Reserved IPv6: %s", d.Id())
+	_, err := deleteReservedIPv6(context.Background(), client, d.Id())
+	if err != nil {
+		return diag.Errorf("Error deleting Reserved IPv6: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
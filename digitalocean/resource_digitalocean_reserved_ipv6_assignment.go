@@ -0,0 +1,135 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanReservedIPv6Assignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanReservedIPv6AssignmentCreate,
+		ReadContext:   resourceDigitalOceanReservedIPv6AssignmentRead,
+		DeleteContext: resourceDigitalOceanReservedIPv6AssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanReservedIPv6AssignmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv6Address,
+			},
+
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanReservedIPv6AssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	ipAddress := d.Get("ip_address").(string)
+	dropletID := d.Get("droplet_id").(int)
+
+	log.Printf("[INFO] Assigning the Reserved IPv6 (%s) to the Droplet %d", ipAddress, dropletID)
+	action, _, err := assignReservedIPv6(context.Background(), client, ipAddress, dropletID)
+	if err != nil {
+		return diag.Errorf("Error assigning Reserved IPv6 (%s) to the droplet: %s", ipAddress, err)
+	}
+
+	if err := waitForReservedIPv6ActionCompleted(ctx, meta, ipAddress, action.ID); err != nil {
+		return diag.Errorf("Error waiting for Reserved IPv6 (%s) to be assigned: %s", ipAddress, err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%d-%s-", dropletID, ipAddress)))
+	return resourceDigitalOceanReservedIPv6AssignmentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanReservedIPv6AssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	ipAddress := d.Get("ip_address").(string)
+	dropletID := d.Get("droplet_id").(int)
+
+	log.Printf("[INFO] Reading the details of the Reserved IPv6 %s", ipAddress)
+	reservedIP, resp, err := getReservedIPv6(context.Background(), client, ipAddress)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Reserved IPv6 (%s) not found", ipAddress)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Reserved IPv6: %s", err)
+	}
+
+	if reservedIP.Droplet == nil || reservedIP.Droplet.ID != dropletID {
+		log.Printf("[INFO] Reserved IPv6 (%s) is no longer assigned to the Droplet %d", ipAddress, dropletID)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanReservedIPv6AssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	ipAddress := d.Get("ip_address").(string)
+	dropletID := d.Get("droplet_id").(int)
+
+	log.Printf("[INFO] Reading the details of the Reserved IPv6 %s", ipAddress)
+	reservedIP, _, err := getReservedIPv6(context.Background(), client, ipAddress)
+	if err != nil {
+		return diag.Errorf("Error retrieving Reserved IPv6: %s", err)
+	}
+
+	if reservedIP.Droplet != nil && reservedIP.Droplet.ID == dropletID {
+		log.Printf("[INFO] Unassigning the Reserved IPv6 from the Droplet")
+		action, _, err := unassignReservedIPv6(context.Background(), client, ipAddress)
+		if err != nil {
+			return diag.Errorf("Error unassigning Reserved IPv6 (%s) from the droplet: %s", ipAddress, err)
+		}
+
+		if err := waitForReservedIPv6ActionCompleted(ctx, meta, ipAddress, action.ID); err != nil {
+			return diag.Errorf("Error waiting for Reserved IPv6 (%s) to be unassigned: %s", ipAddress, err)
+		}
+	} else {
+		log.Printf("[INFO] Reserved IPv6 already unassigned, removing from state.")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanReservedIPv6AssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("Must use the reserved IPv6 and the ID of the Droplet joined with a comma (e.g. `ip_address,droplet_id`)")
+	}
+
+	dropletID, err := strconv.Atoi(s[1])
+	if err != nil || s[0] == "" {
+		return nil, fmt.Errorf("Invalid reserved IPv6 assignment import ID %q, expected ip_address,droplet_id", d.Id())
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%d-%s-", dropletID, s[0])))
+	d.Set("ip_address", s[0])
+	d.Set("droplet_id", dropletID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,133 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanReservedIPv6Assignment(t *testing.T) {
+	var reservedIP reservedIPv6
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanReservedIPv6Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanReservedIPv6AssignmentConfig(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPv6AttachmentExists("digitalocean_reserved_ipv6_assignment.foobar"),
+					resource.TestMatchResourceAttr(
+						"digitalocean_reserved_ipv6_assignment.foobar", "droplet_id", regexp.MustCompile("[0-9]+")),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPv6AssignmentConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPv6AttachmentExists("digitalocean_reserved_ipv6_assignment.foobar"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_reserved_ipv6_assignment.foobar", "droplet_id",
+						"digitalocean_droplet.foobar.1", "id"),
+				),
+			},
+			{
+				ResourceName: "digitalocean_reserved_ipv6_assignment.foobar",
+				ImportState:  true,
+				// The ID of reserved IPv6 assignments is generated, it
+				// differs after the import.
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["digitalocean_reserved_ipv6_assignment.foobar"]
+					return fmt.Sprintf("%s,%s", rs.Primary.Attributes["ip_address"], rs.Primary.Attributes["droplet_id"]), nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["ip_address"] == "" || states[0].Attributes["droplet_id"] == "" {
+						return fmt.Errorf("Expected ip_address and droplet_id to be imported, got %v", states)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPv6AssignmentDeleteAssignment(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPv6Exists("digitalocean_reserved_ipv6.foobar", &reservedIP),
+					resource.TestCheckResourceAttrSet("digitalocean_reserved_ipv6.foobar", "ip_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanReservedIPv6AttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["ip_address"] == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+		ip := rs.Primary.Attributes["ip_address"]
+		dropletID, err := strconv.Atoi(rs.Primary.Attributes["droplet_id"])
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		foundReservedIP, _, err := getReservedIPv6(context.Background(), client, ip)
+		if err != nil {
+			return err
+		}
+
+		if foundReservedIP.IP != ip || foundReservedIP.Droplet == nil || foundReservedIP.Droplet.ID != dropletID {
+			return fmt.Errorf("Wrong reserved IPv6 assignment found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanReservedIPv6AssignmentConfig(name string, dropletIndex int) string {
+	return fmt.Sprintf(`
+resource "digitalocean_reserved_ipv6" "foobar" {
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  count  = 2
+  name   = "%s-${count.index}"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-20-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}
+
+resource "digitalocean_reserved_ipv6_assignment" "foobar" {
+  ip_address = digitalocean_reserved_ipv6.foobar.ip_address
+  droplet_id = digitalocean_droplet.foobar[%d].id
+}`, name, dropletIndex)
+}
+
+func testAccCheckDigitalOceanReservedIPv6AssignmentDeleteAssignment(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_reserved_ipv6" "foobar" {
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  count  = 2
+  name   = "%s-${count.index}"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-20-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}`, name)
+}
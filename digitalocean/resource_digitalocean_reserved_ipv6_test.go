@@ -0,0 +1,148 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanReservedIPv6_Region(t *testing.T) {
+	var reservedIP reservedIPv6
+
+	expectedURNRegEx, _ := regexp.Compile(`do:reservedipv6:[0-9a-fA-F:]+$`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanReservedIPv6Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanReservedIPv6Config_region,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPv6Exists("digitalocean_reserved_ipv6.foobar", &reservedIP),
+					resource.TestCheckResourceAttr(
+						"digitalocean_reserved_ipv6.foobar", "region", "nyc3"),
+					resource.TestCheckResourceAttrSet("digitalocean_reserved_ipv6.foobar", "ip_address"),
+					resource.TestMatchResourceAttr("digitalocean_reserved_ipv6.foobar", "urn", expectedURNRegEx),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanReservedIPv6_Droplet(t *testing.T) {
+	var reservedIP reservedIPv6
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanReservedIPv6Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanReservedIPv6Config_droplet(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPv6Exists("digitalocean_reserved_ipv6.foobar", &reservedIP),
+					resource.TestCheckResourceAttr(
+						"digitalocean_reserved_ipv6.foobar", "region", "nyc3"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_reserved_ipv6.foobar", "droplet_id",
+						"digitalocean_droplet.foobar", "id"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanReservedIPv6Config_unassign(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanReservedIPv6Exists("digitalocean_reserved_ipv6.foobar", &reservedIP),
+					resource.TestCheckResourceAttr(
+						"digitalocean_reserved_ipv6.foobar", "region", "nyc3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanReservedIPv6Destroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_reserved_ipv6" {
+			continue
+		}
+
+		_, _, err := getReservedIPv6(context.Background(), client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Reserved IPv6 still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanReservedIPv6Exists(n string, reservedIP *reservedIPv6) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		foundReservedIP, _, err := getReservedIPv6(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundReservedIP.IP != rs.Primary.ID {
+			return fmt.Errorf("Record not found")
+		}
+
+		*reservedIP = *foundReservedIP
+
+		return nil
+	}
+}
+
+var testAccCheckDigitalOceanReservedIPv6Config_region = `
+resource "digitalocean_reserved_ipv6" "foobar" {
+  region = "nyc3"
+}`
+
+func testAccCheckDigitalOceanReservedIPv6Config_droplet(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-20-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}
+
+resource "digitalocean_reserved_ipv6" "foobar" {
+  droplet_id = digitalocean_droplet.foobar.id
+  region     = digitalocean_droplet.foobar.region
+}`, name)
+}
+
+func testAccCheckDigitalOceanReservedIPv6Config_unassign(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-20-04-x64"
+  region = "nyc3"
+  ipv6   = true
+}
+
+resource "digitalocean_reserved_ipv6" "foobar" {
+  region = "nyc3"
+}`, name)
+}
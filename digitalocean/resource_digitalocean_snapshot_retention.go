@@ -0,0 +1,155 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanSnapshotRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanSnapshotRetentionCreate,
+		ReadContext:   resourceDigitalOceanSnapshotRetentionRead,
+		UpdateContext: resourceDigitalOceanSnapshotRetentionUpdate,
+		DeleteContext: resourceDigitalOceanSnapshotRetentionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"droplet", "volume"}, false),
+			},
+			"name_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				AtLeastOneOf: []string{"name_prefix", "tag"},
+			},
+			"tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				AtLeastOneOf: []string{"name_prefix", "tag"},
+			},
+			"keep_last": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				AtLeastOneOf: []string{"keep_last", "max_age_days"},
+			},
+			"max_age_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				AtLeastOneOf: []string{"keep_last", "max_age_days"},
+			},
+			"snapshot_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"expired_snapshot_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		// The snapshots that expired since the last apply are deleted by
+		// the next one, the plan lists them.
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			if len(diff.Get("expired_snapshot_ids").([]interface{})) > 0 {
+				return diff.SetNew("expired_snapshot_ids", []interface{}{})
+			}
+			return nil
+		},
+	}
+}
+
+func resourceDigitalOceanSnapshotRetentionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(resource.PrefixedUniqueId(d.Get("resource_type").(string) + "-"))
+
+	if err := deleteExpiredSnapshots(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDigitalOceanSnapshotRetentionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanSnapshotRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	snapshots, err := listSnapshots(ctx, client, d.Get("resource_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	kept, expired := expandSnapshotRetention(d).apply(snapshots, time.Now())
+
+	d.Set("snapshot_ids", flattenSnapshotIDs(kept))
+	d.Set("expired_snapshot_ids", flattenSnapshotIDs(expired))
+
+	return nil
+}
+
+func resourceDigitalOceanSnapshotRetentionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteExpiredSnapshots(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDigitalOceanSnapshotRetentionRead(ctx, d, meta)
+}
+
+// resourceDigitalOceanSnapshotRetentionDelete only removes the policy from the
+// state, the snapshots it kept are left in place.
+func resourceDigitalOceanSnapshotRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func expandSnapshotRetention(d *schema.ResourceData) *snapshotRetention {
+	return &snapshotRetention{
+		namePrefix: d.Get("name_prefix").(string),
+		tag:        d.Get("tag").(string),
+		keepLast:   d.Get("keep_last").(int),
+		maxAge:     time.Duration(d.Get("max_age_days").(int)) * 24 * time.Hour,
+	}
+}
+
+// deleteExpiredSnapshots deletes the snapshots expired by the policy now,
+// which may include snapshots taken since the plan.
+func deleteExpiredSnapshots(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*CombinedConfig).godoClient()
+
+	snapshots, err := listSnapshots(ctx, client, d.Get("resource_type").(string))
+	if err != nil {
+		return err
+	}
+
+	_, expired := expandSnapshotRetention(d).apply(snapshots, time.Now())
+	for _, snapshot := range expired {
+		log.Printf("[INFO] Deleting expired snapshot %s (%s) created at %s", snapshot.Name, snapshot.ID, snapshot.Created)
+		resp, err := client.Snapshots.Delete(ctx, snapshot.ID)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return fmt.Errorf("Error deleting expired snapshot %s (%s): %s", snapshot.Name, snapshot.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenSnapshotIDs(snapshots []godo.Snapshot) []string {
+	ids := make([]string, len(snapshots))
+	for i, snapshot := range snapshots {
+		ids[i] = snapshot.ID
+	}
+	return ids
+}
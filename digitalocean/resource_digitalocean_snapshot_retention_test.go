@@ -0,0 +1,152 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanSnapshotRetention_KeepLast(t *testing.T) {
+	rInt := acctest.RandInt()
+	prefix := fmt.Sprintf("snapshot-retention-%d-", rInt)
+	resourceName := "digitalocean_snapshot_retention.foobar"
+
+	volumeConfig := fmt.Sprintf(`
+resource "digitalocean_volume" "foo" {
+  region = "nyc1"
+  name   = "volume-%d"
+  size   = 10
+}
+`, rInt)
+
+	retentionConfig := fmt.Sprintf(`
+resource "digitalocean_snapshot_retention" "foobar" {
+  resource_type = "volume"
+  name_prefix   = "%s"
+  keep_last     = 1
+}
+`, prefix)
+
+	var volumeID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanVolumeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: volumeConfig,
+				Check: func(s *terraform.State) error {
+					volumeID = s.RootModule().Resources["digitalocean_volume.foo"].Primary.ID
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+					for i := 0; i < 3; i++ {
+						_, _, err := client.Storage.CreateSnapshot(context.Background(), &godo.SnapshotCreateRequest{
+							VolumeID: volumeID,
+							Name:     fmt.Sprintf("%s%d", prefix, i),
+						})
+						if err != nil {
+							t.Fatalf("Error creating volume snapshot: %s", err)
+						}
+						// The snapshots are ordered by their creation date.
+						time.Sleep(2 * time.Second)
+					}
+				},
+				Config: volumeConfig + retentionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "snapshot_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "expired_snapshot_ids.#", "0"),
+					testAccCheckDigitalOceanSnapshotRetentionKept("volume", prefix, fmt.Sprintf("%s2", prefix)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanSnapshotRetentionKept(resourceType, prefix, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		snapshots, err := listSnapshots(context.Background(), client, resourceType)
+		if err != nil {
+			return err
+		}
+
+		var names []string
+		for _, snapshot := range snapshots {
+			if strings.HasPrefix(snapshot.Name, prefix) {
+				names = append(names, snapshot.Name)
+			}
+		}
+
+		if len(names) != 1 || names[0] != expected {
+			return fmt.Errorf("expected only snapshot %s to be kept, found %v", expected, names)
+		}
+
+		return nil
+	}
+}
+
+func TestSnapshotRetention_Apply(t *testing.T) {
+	now := time.Date(2021, 9, 30, 0, 0, 0, 0, time.UTC)
+	day := func(n int) string {
+		return now.Add(-time.Duration(n) * 24 * time.Hour).Format(time.RFC3339)
+	}
+
+	snapshots := []godo.Snapshot{
+		{ID: "old", Name: "web-old", Created: day(40), Tags: []string{"web"}},
+		{ID: "new", Name: "web-new", Created: day(1), Tags: []string{"web"}},
+		{ID: "mid", Name: "web-mid", Created: day(10)},
+		{ID: "other", Name: "db-new", Created: day(50), Tags: []string{"web"}},
+		{ID: "undated", Name: "web-undated", Created: "unknown"},
+	}
+
+	cases := []struct {
+		retention snapshotRetention
+		kept      []string
+		expired   []string
+	}{
+		{
+			retention: snapshotRetention{namePrefix: "web-", keepLast: 2},
+			kept:      []string{"new", "mid", "undated"},
+			expired:   []string{"old"},
+		},
+		{
+			retention: snapshotRetention{namePrefix: "web-", maxAge: 7 * 24 * time.Hour},
+			kept:      []string{"new", "undated"},
+			expired:   []string{"mid", "old"},
+		},
+		{
+			// At least the most recent snapshot is kept, however old it
+			// is.
+			retention: snapshotRetention{tag: "web", keepLast: 1, maxAge: 24 * time.Hour},
+			kept:      []string{"new"},
+			expired:   []string{"old", "other"},
+		},
+		{
+			retention: snapshotRetention{namePrefix: "web-", tag: "web", keepLast: 1},
+			kept:      []string{"new"},
+			expired:   []string{"old"},
+		},
+	}
+
+	for _, c := range cases {
+		kept, expired := c.retention.apply(snapshots, now)
+		if fmt.Sprint(flattenSnapshotIDs(kept)) != fmt.Sprint(c.kept) {
+			t.Errorf("expected %+v to keep %v, got %v", c.retention, c.kept, flattenSnapshotIDs(kept))
+		}
+		if fmt.Sprint(flattenSnapshotIDs(expired)) != fmt.Sprint(c.expired) {
+			t.Errorf("expected %+v to expire %v, got %v", c.retention, c.expired, flattenSnapshotIDs(expired))
+		}
+	}
+}
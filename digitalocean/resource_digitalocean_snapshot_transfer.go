@@ -0,0 +1,162 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanSnapshotTransfer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanSnapshotTransferCreate,
+		ReadContext:   resourceDigitalOceanSnapshotTransferRead,
+		UpdateContext: resourceDigitalOceanSnapshotTransferUpdate,
+		DeleteContext: resourceDigitalOceanSnapshotTransferDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanSnapshotTransferImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"regions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"available_regions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanSnapshotTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("snapshot_id").(string))
+
+	if err := transferSnapshotToRegions(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDigitalOceanSnapshotTransferRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanSnapshotTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	snapshot, resp, err := client.Snapshots.Get(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Snapshot (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving snapshot (%s): %s", d.Id(), err)
+	}
+
+	// Only the regions the snapshot was transferred to are tracked, a
+	// region it is no longer available in shows up as a change. All of
+	// them are tracked when importing the resource.
+	regions := snapshot.Regions
+	if v, ok := d.GetOk("regions"); ok {
+		regions = nil
+		for _, region := range snapshot.Regions {
+			if v.(*schema.Set).Contains(region) {
+				regions = append(regions, region)
+			}
+		}
+	}
+
+	d.Set("snapshot_id", snapshot.ID)
+	d.Set("regions", regions)
+	d.Set("available_regions", snapshot.Regions)
+
+	return nil
+}
+
+func resourceDigitalOceanSnapshotTransferUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("regions") {
+		if err := transferSnapshotToRegions(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDigitalOceanSnapshotTransferRead(ctx, d, meta)
+}
+
+// resourceDigitalOceanSnapshotTransferDelete only removes the transfer from
+// the state, snapshots can not be removed from a region.
+func resourceDigitalOceanSnapshotTransferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Snapshot (%s) remains available in its regions, they can not be removed from a snapshot", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanSnapshotTransferImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("snapshot_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// transferSnapshotToRegions transfers the snapshot to the configured regions
+// it is not available in yet, one after the other.
+func transferSnapshotToRegions(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*CombinedConfig).godoClient()
+
+	snapshot, _, err := client.Snapshots.Get(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving snapshot (%s): %s", d.Id(), err)
+	}
+
+	// Volume snapshots are not images, the API can not transfer them.
+	if snapshot.ResourceType != "droplet" {
+		return fmt.Errorf("Error transferring snapshot (%s): only Droplet snapshots can be transferred to other regions, not %s snapshots", d.Id(), snapshot.ResourceType)
+	}
+
+	id, err := strconv.Atoi(snapshot.ID)
+	if err != nil {
+		return fmt.Errorf("Error transferring snapshot (%s): invalid ID: %s", d.Id(), err)
+	}
+
+	available := schema.NewSet(schema.HashString, nil)
+	for _, region := range snapshot.Regions {
+		available.Add(region)
+	}
+
+	var regions []interface{}
+	for _, region := range d.Get("regions").(*schema.Set).List() {
+		if !available.Contains(region) {
+			regions = append(regions, region)
+		}
+	}
+	if len(regions) == 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Transferring snapshot (%s) to: %v", d.Id(), regions)
+	if err := distributeImageToRegions(ctx, meta, id, regions, timeout); err != nil {
+		return fmt.Errorf("Error transferring snapshot (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package digitalocean
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanSnapshotTransfer_Basic(t *testing.T) {
+	name := randomTestName()
+	resourceName := "digitalocean_snapshot_transfer.foobar"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanSnapshotTransferConfig_basic(name, `["nyc3", "sfo3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", "digitalocean_droplet_snapshot.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "available_regions.*", "nyc3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "available_regions.*", "sfo3"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanSnapshotTransferConfig_basic(name, `["nyc3", "sfo3", "ams3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "available_regions.*", "ams3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanSnapshotTransfer_VolumeSnapshot(t *testing.T) {
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanVolumeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_volume" "foo" {
+  region = "nyc1"
+  name   = "%s"
+  size   = 10
+}
+
+resource "digitalocean_volume_snapshot" "foobar" {
+  name      = "%s"
+  volume_id = digitalocean_volume.foo.id
+}
+
+resource "digitalocean_snapshot_transfer" "foobar" {
+  snapshot_id = digitalocean_volume_snapshot.foobar.id
+  regions     = ["sfo3"]
+}
+`, name, name),
+				ExpectError: regexp.MustCompile("only Droplet snapshots can be transferred to other regions"),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanSnapshotTransferConfig_basic(name, regions string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foo" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-20-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet_snapshot" "foobar" {
+  droplet_id = digitalocean_droplet.foo.id
+  name       = "%s"
+}
+
+resource "digitalocean_snapshot_transfer" "foobar" {
+  snapshot_id = digitalocean_droplet_snapshot.foobar.id
+  regions     = %s
+}
+`, name, name, regions)
+}
@@ -28,6 +28,8 @@ func resourceDigitalOceanBucket() *schema.Resource {
 			State: resourceDigitalOceanBucketImport,
 		},
 
+		CustomizeDiff: setSpacesDefaultRegion("nyc3"),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -42,9 +44,9 @@ func resourceDigitalOceanBucket() *schema.Resource {
 			"region": {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
-				Description:  "Bucket region",
-				Default:      "nyc3",
+				Description:  "Bucket region, defaults to the spaces_default_region of the provider or nyc3",
 				ValidateFunc: validation.StringInSlice(SpacesRegions, true),
 				StateFunc: func(val interface{}) string {
 					// DO API V2 region slug is always lowercase
@@ -764,8 +766,14 @@ func resourceDigitalOceanBucketImport(d *schema.ResourceData, meta interface{})
 		d.Set("region", s[0])
 	}
 
+	if d.Get("region") == "" {
+		if region := meta.(*CombinedConfig).spacesDefaultRegion; region != "" {
+			d.Set("region", strings.ToLower(region))
+		}
+	}
+
 	if d.Id() == "" || d.Get("region") == "" {
-		return nil, fmt.Errorf("importing a Spaces bucket requires the format: <region>,<name>")
+		return nil, fmt.Errorf("importing a Spaces bucket requires the format: <region>,<name>, or <name> when spaces_default_region is set in the provider")
 	}
 
 	return []*schema.ResourceData{d}, nil
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
@@ -25,13 +26,20 @@ func resourceDigitalOceanSpacesBucketObject() *schema.Resource {
 		ReadContext:   resourceDigitalOceanSpacesBucketObjectRead,
 		UpdateContext: resourceDigitalOceanSpacesBucketObjectUpdate,
 		DeleteContext: resourceDigitalOceanSpacesBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanSpacesBucketObjectImport,
+		},
 
-		CustomizeDiff: resourceDigitalOceanSpacesBucketObjectCustomizeDiff,
+		CustomizeDiff: customdiff.All(
+			setSpacesDefaultRegion(""),
+			resourceDigitalOceanSpacesBucketObjectCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(SpacesRegions, true),
 			},
@@ -562,3 +570,33 @@ func pointersMapToStringList(pointers map[string]*string) map[string]interface{}
 	}
 	return list
 }
+
+// resourceDigitalOceanSpacesBucketObjectImport imports an object using its
+// region, bucket and key joined with commas. The region can be omitted if the
+// provider sets spaces_default_region. Keys can contain commas.
+func resourceDigitalOceanSpacesBucketObjectImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var region string
+	s := strings.SplitN(d.Id(), ",", 3)
+	if len(s) == 3 && isSpacesRegion(s[0]) {
+		region = s[0]
+		s = s[1:]
+	} else {
+		s = strings.SplitN(d.Id(), ",", 2)
+	}
+
+	if len(s) != 2 || s[0] == "" || s[1] == "" {
+		return nil, fmt.Errorf("importing a Spaces bucket object requires the format: <region>,<bucket>,<key>")
+	}
+
+	region, err := meta.(*CombinedConfig).spacesRegion(region)
+	if err != nil {
+		return nil, fmt.Errorf("importing a Spaces bucket object requires the format: <region>,<bucket>,<key>: %s", err)
+	}
+
+	d.SetId(s[1])
+	d.Set("region", region)
+	d.Set("bucket", s[0])
+	d.Set("key", s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,9 +2,11 @@ package digitalocean
 
 import (
 	"fmt"
+	"log"
 	"os"
 	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -18,6 +20,67 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
+func init() {
+	resource.AddTestSweepers("digitalocean_spaces_bucket", &resource.Sweeper{
+		Name:         "digitalocean_spaces_bucket",
+		F:            testSweepSpacesBuckets,
+		Dependencies: []string{"digitalocean_cdn"},
+	})
+}
+
+func testSweepSpacesBuckets(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	if os.Getenv("SPACES_ACCESS_KEY_ID") == "" || os.Getenv("SPACES_SECRET_ACCESS_KEY") == "" {
+		log.Printf("[WARN] Skipping the Spaces buckets, SPACES_ACCESS_KEY_ID and SPACES_SECRET_ACCESS_KEY must be set to sweep them")
+		return nil
+	}
+
+	// Buckets are listed per region, the ones of every region are swept.
+	for _, spacesRegion := range SpacesRegions {
+		client, err := meta.(*CombinedConfig).spacesClient(spacesRegion)
+		if err != nil {
+			return err
+		}
+
+		conn := s3.New(client)
+		buckets, err := conn.ListBuckets(&s3.ListBucketsInput{})
+		if err != nil {
+			return err
+		}
+
+		for _, b := range buckets.Buckets {
+			name := aws.StringValue(b.Name)
+			if !strings.HasPrefix(name, "tf-test-bucket-") && !strings.HasPrefix(name, "tf-object-test-bucket-") &&
+				!strings.HasPrefix(name, "tf-acc-objects-test-bucket-") && !strings.HasPrefix(name, "tf-cdn-test-bucket-") {
+				continue
+			}
+
+			location, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: b.Name})
+			if err != nil {
+				return err
+			}
+			if aws.StringValue(location.LocationConstraint) != spacesRegion {
+				continue
+			}
+
+			log.Printf("Destroying Spaces bucket %s in %s", name, spacesRegion)
+
+			if err := deleteAllS3ObjectVersions(conn, name, "", true, true); err != nil {
+				return err
+			}
+			if _, err := conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: b.Name}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func TestAccDigitalOceanBucket_basic(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -68,6 +131,28 @@ func TestAccDigitalOceanBucket_region(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanBucket_DefaultRegion(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanBucketConfigDefaultRegion(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanBucketExists("digitalocean_spaces_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_spaces_bucket.bucket", "region", "sfo3"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_spaces_bucket_object.object", "region", "sfo3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanBucket_UpdateAcl(t *testing.T) {
 	ri := acctest.RandInt()
 	preConfig := fmt.Sprintf(testAccDigitalOceanBucketConfigWithACL, ri)
@@ -663,6 +748,25 @@ resource "digitalocean_spaces_bucket" "bucket" {
 `, randInt)
 }
 
+func testAccDigitalOceanBucketConfigDefaultRegion(randInt int) string {
+	return fmt.Sprintf(`
+provider "digitalocean" {
+  spaces_default_region = "sfo3"
+}
+
+resource "digitalocean_spaces_bucket" "bucket" {
+  name          = "tf-test-bucket-%d"
+  force_destroy = true
+}
+
+resource "digitalocean_spaces_bucket_object" "object" {
+  bucket  = digitalocean_spaces_bucket.bucket.name
+  key     = "test-key"
+  content = "test"
+}
+`, randInt)
+}
+
 func testAccDigitalOceanBucketConfigImport(randInt int) string {
 	return fmt.Sprintf(`
 resource "digitalocean_spaces_bucket" "bucket" {
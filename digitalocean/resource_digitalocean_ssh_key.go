@@ -2,7 +2,9 @@ package digitalocean
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -34,7 +36,7 @@ func resourceDigitalOceanSSHKey() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: resourceDigitalOceanSSHKeyPublicKeyDiffSuppress,
-				ValidateFunc:     validation.NoZeroValues,
+				ValidateFunc:     validateSSHPublicKey,
 			},
 
 			"fingerprint": {
@@ -42,9 +44,35 @@ func resourceDigitalOceanSSHKey() *schema.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: resourceDigitalOceanSSHKeyCustomizeDiff,
 	}
 }
 
+// resourceDigitalOceanSSHKeyCustomizeDiff plans the fingerprint of new and
+// replaced keys, so that the resources referencing the key by fingerprint
+// know ahead of the apply that it changes.
+func resourceDigitalOceanSSHKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("public_key") {
+		return nil
+	}
+
+	fingerprint, err := sshKeyFingerprint(d.Get("public_key").(string))
+	if err != nil {
+		// The public key is validated before the diff is computed.
+		return nil
+	}
+
+	if d.Get("fingerprint").(string) == fingerprint {
+		return nil
+	}
+
+	if d.Id() != "" {
+		log.Printf("[INFO] The public key of SSH key (%s) changed, DigitalOcean cannot update it in place so the key will be replaced", d.Id())
+	}
+	return d.SetNew("fingerprint", fingerprint)
+}
+
 func resourceDigitalOceanSSHKeyPublicKeyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	return strings.TrimSpace(old) == strings.TrimSpace(new)
 }
@@ -59,8 +87,18 @@ func resourceDigitalOceanSSHKeyCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	log.Printf("[DEBUG] SSH Key create configuration: %#v", opts)
-	key, _, err := client.Keys.Create(context.Background(), opts)
+	key, resp, err := client.Keys.Create(context.Background(), opts)
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnprocessableEntity && strings.Contains(strings.ToLower(err.Error()), "already in use") {
+			fingerprint, _ := sshKeyFingerprint(opts.PublicKey)
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error creating SSH Key: %s", err),
+				Detail: fmt.Sprintf("A public key can only be added once to an account, and the key with fingerprint %s already exists. "+
+					"Import it with terraform import, or remove it before creating it again. When rotating keys with "+
+					"create_before_destroy, the new public key must differ from the previous one.", fingerprint),
+			}}
+		}
 		return diag.Errorf("Error creating SSH Key: %s", err)
 	}
 
@@ -90,11 +128,23 @@ func resourceDigitalOceanSSHKeyRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Error retrieving SSH key: %s", err)
 	}
 
+	var diags diag.Diagnostics
+	if previous := d.Get("fingerprint").(string); previous != "" && previous != key.Fingerprint {
+		log.Printf("[WARN] The fingerprint of SSH key (%s) changed from %s to %s", d.Id(), previous, key.Fingerprint)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("SSH key (%s) was changed outside of Terraform", d.Id()),
+			Detail: fmt.Sprintf("Its fingerprint changed from %s to %s. DigitalOcean cannot change the public key of an "+
+				"existing SSH key, so the key will be replaced if the configuration does not match it. The Droplets "+
+				"created with the previous key keep it in their authorized keys.", previous, key.Fingerprint),
+		})
+	}
+
 	d.Set("name", key.Name)
 	d.Set("fingerprint", key.Fingerprint)
 	d.Set("public_key", key.PublicKey)
 
-	return nil
+	return diags
 }
 
 func resourceDigitalOceanSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
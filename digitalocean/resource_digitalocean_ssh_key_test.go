@@ -3,7 +3,9 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -12,6 +14,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("digitalocean_ssh_key", &resource.Sweeper{
+		Name: "digitalocean_ssh_key",
+		F:    testSweepSSHKeys,
+	})
+}
+
+func testSweepSSHKeys(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*CombinedConfig).godoClient()
+
+	opt := &godo.ListOptions{PerPage: 200}
+	keys, _, err := client.Keys.List(context.Background(), opt)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if strings.HasPrefix(k.Name, "foobar-") || strings.HasPrefix(k.Name, "tf-acc-test") {
+			log.Printf("Destroying SSH key %s", k.Name)
+
+			if _, err := client.Keys.DeleteByID(context.Background(), k.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func TestAccDigitalOceanSSHKey_Basic(t *testing.T) {
 	var key godo.Key
 	rInt := acctest.RandInt()
@@ -39,6 +75,73 @@ func TestAccDigitalOceanSSHKey_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanSSHKey_Rotate(t *testing.T) {
+	var before, after godo.Key
+	rInt := acctest.RandInt()
+	publicKeyBefore, _, err := acctest.RandSSHKeyPair("digitalocean@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+	publicKeyAfter, _, err := acctest.RandSSHKeyPair("digitalocean@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+	fingerprintAfter, err := sshKeyFingerprint(publicKeyAfter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanSSHKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanSSHKeyConfig_rotate(rInt, publicKeyBefore),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanSSHKeyExists("digitalocean_ssh_key.foobar", &before),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanSSHKeyConfig_rotate(rInt, publicKeyAfter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanSSHKeyExists("digitalocean_ssh_key.foobar", &after),
+					resource.TestCheckResourceAttr(
+						"digitalocean_ssh_key.foobar", "fingerprint", fingerprintAfter),
+					func(s *terraform.State) error {
+						if before.ID == after.ID {
+							return fmt.Errorf("expected the SSH key to be replaced, it is still %d", after.ID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestSSHKeyFingerprint(t *testing.T) {
+	// ssh-keygen -l -E md5 prints the same fingerprint for this key.
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl foo@example.com\n"
+
+	fingerprint, err := sshKeyFingerprint(publicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := "65:96:2d:fc:e8:d5:a9:11:64:0c:0f:ea:00:6e:5b:bd"; fingerprint != expected {
+		t.Errorf("expected fingerprint %s, got %s", expected, fingerprint)
+	}
+
+	for _, invalid := range []string{"", "foobar", "ssh-rsa notbase64"} {
+		if _, errs := validateSSHPublicKey(invalid, "public_key"); len(errs) == 0 {
+			t.Errorf("expected %q not to be a valid public key", invalid)
+		}
+	}
+	if _, errs := validateSSHPublicKey(publicKey, "public_key"); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
+
 func testAccCheckDigitalOceanSSHKeyDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
 
@@ -106,3 +209,15 @@ resource "digitalocean_ssh_key" "foobar" {
     public_key = "%s"
 }`, rInt, key)
 }
+
+func testAccCheckDigitalOceanSSHKeyConfig_rotate(rInt int, key string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_ssh_key" "foobar" {
+  name       = "foobar-%d"
+  public_key = "%s"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}`, rInt, key)
+}
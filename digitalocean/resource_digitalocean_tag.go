@@ -89,12 +89,9 @@ func resourceDigitalOceanTagRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set("name", tag.Name)
-	d.Set("total_resource_count", tag.Resources.Count)
-	d.Set("droplets_count", tag.Resources.Droplets.Count)
-	d.Set("images_count", tag.Resources.Images.Count)
-	d.Set("volumes_count", tag.Resources.Volumes.Count)
-	d.Set("volume_snapshots_count", tag.Resources.VolumeSnapshots.Count)
-	d.Set("databases_count", tag.Resources.Databases.Count)
+	for key, count := range flattenTagResourceCounts(tag.Resources) {
+		d.Set(key, count)
+	}
 
 	return nil
 }
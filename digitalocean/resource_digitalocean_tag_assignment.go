@@ -0,0 +1,204 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tagAssignmentResourceTypes maps the types of the URNs that can be tagged to
+// the types of resources of the tags API.
+var tagAssignmentResourceTypes = map[string]godo.ResourceType{
+	"droplet": godo.DropletResourceType,
+	"volume":  godo.VolumeResourceType,
+	"image":   godo.ImageResourceType,
+	"dbaas":   godo.DatabaseResourceType,
+}
+
+func resourceDigitalOceanTagAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanTagAssignmentCreate,
+		ReadContext:   resourceDigitalOceanTagAssignmentRead,
+		DeleteContext: resourceDigitalOceanTagAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanTagAssignmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "name of the tag to apply",
+				ValidateFunc: validateTag,
+			},
+			"urn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "uniform resource name of the resource to tag, e.g. do:droplet:4126873",
+				ValidateFunc: validateTagAssignmentURN,
+			},
+		},
+	}
+}
+
+func validateTagAssignmentURN(v interface{}, k string) ([]string, []error) {
+	if _, err := expandTagAssignmentURN(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q: %s", k, err)}
+	}
+
+	return nil, nil
+}
+
+// expandTagAssignmentURN returns the resource of the tags API identified by
+// urn.
+func expandTagAssignmentURN(urn string) (godo.Resource, error) {
+	urnType, id, err := parseURN(urn)
+	if err != nil {
+		return godo.Resource{}, err
+	}
+
+	resourceType, ok := tagAssignmentResourceTypes[urnType]
+	if !ok {
+		return godo.Resource{}, fmt.Errorf("resources of type %q cannot be tagged, expected a droplet, volume, image or dbaas URN", urnType)
+	}
+
+	if resourceType == godo.DropletResourceType || resourceType == godo.ImageResourceType {
+		if _, err := strconv.Atoi(id); err != nil {
+			return godo.Resource{}, fmt.Errorf("invalid %s ID %q in URN %q", urnType, id, urn)
+		}
+	}
+
+	return godo.Resource{ID: id, Type: resourceType}, nil
+}
+
+func resourceDigitalOceanTagAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	tag := d.Get("tag").(string)
+	urn := d.Get("urn").(string)
+	res, err := expandTagAssignmentURN(urn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Applying tag %s to %s", tag, urn)
+	_, err = client.Tags.TagResources(ctx, tag, &godo.TagResourcesRequest{
+		Resources: []godo.Resource{res},
+	})
+	if err != nil {
+		return diag.Errorf("Error applying tag %s to %s: %s", tag, urn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", tag, urn))
+
+	return resourceDigitalOceanTagAssignmentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanTagAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	tag := d.Get("tag").(string)
+	urn := d.Get("urn").(string)
+	res, err := expandTagAssignmentURN(urn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags, resp, err := getTaggedResourceTags(ctx, client, res)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] %s not found, removing the assignment of tag %s from state", urn, tag)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving %s: %s", urn, err)
+	}
+
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Tag %s was removed from %s, removing the assignment from state", tag, urn)
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanTagAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	tag := d.Get("tag").(string)
+	urn := d.Get("urn").(string)
+	res, err := expandTagAssignmentURN(urn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Removing tag %s from %s", tag, urn)
+	resp, err := client.Tags.UntagResources(ctx, tag, &godo.UntagResourcesRequest{
+		Resources: []godo.Resource{res},
+	})
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return diag.Errorf("Error removing tag %s from %s: %s", tag, urn, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanTagAssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.SplitN(d.Id(), ",", 2)
+	if len(s) != 2 || s[0] == "" || s[1] == "" {
+		return nil, fmt.Errorf("must use the tag and the URN of the resource joined with a comma (e.g. `web,do:droplet:4126873`)")
+	}
+
+	d.Set("tag", s[0])
+	d.Set("urn", s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getTaggedResourceTags returns the tags applied to a resource of the tags
+// API.
+func getTaggedResourceTags(ctx context.Context, client *godo.Client, res godo.Resource) ([]string, *godo.Response, error) {
+	switch res.Type {
+	case godo.DropletResourceType:
+		id, _ := strconv.Atoi(res.ID)
+		droplet, resp, err := client.Droplets.Get(ctx, id)
+		if err != nil {
+			return nil, resp, err
+		}
+		return droplet.Tags, resp, nil
+	case godo.ImageResourceType:
+		id, _ := strconv.Atoi(res.ID)
+		image, resp, err := client.Images.GetByID(ctx, id)
+		if err != nil {
+			return nil, resp, err
+		}
+		return image.Tags, resp, nil
+	case godo.VolumeResourceType:
+		volume, resp, err := client.Storage.GetVolume(ctx, res.ID)
+		if err != nil {
+			return nil, resp, err
+		}
+		return volume.Tags, resp, nil
+	case godo.DatabaseResourceType:
+		db, resp, err := client.Databases.Get(ctx, res.ID)
+		if err != nil {
+			return nil, resp, err
+		}
+		return db.Tags, resp, nil
+	}
+
+	return nil, nil, fmt.Errorf("resources of type %q cannot be tagged", res.Type)
+}
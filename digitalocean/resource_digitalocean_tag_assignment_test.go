@@ -0,0 +1,150 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanTagAssignment_Basic(t *testing.T) {
+	tagName := randomTestName()
+	volumeName := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanTagAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanTagAssignmentConfig_basic(tagName, volumeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanTagAssignmentExists("digitalocean_tag_assignment.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_tag_assignment.foobar", "tag", tagName),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_tag_assignment.foobar", "urn", "digitalocean_volume.foobar", "urn"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandTagAssignmentURN(t *testing.T) {
+	cases := []struct {
+		urn      string
+		expected godo.Resource
+		err      bool
+	}{
+		{urn: "do:droplet:4126873", expected: godo.Resource{ID: "4126873", Type: godo.DropletResourceType}},
+		{urn: "do:volume:506f78a4-e098-11e5-ad9f-000f53306ae1", expected: godo.Resource{ID: "506f78a4-e098-11e5-ad9f-000f53306ae1", Type: godo.VolumeResourceType}},
+		{urn: "do:image:7555620", expected: godo.Resource{ID: "7555620", Type: godo.ImageResourceType}},
+		{urn: "do:dbaas:9cc10173-e9ea-4176-9dbc-a4cee4c4ff30", expected: godo.Resource{ID: "9cc10173-e9ea-4176-9dbc-a4cee4c4ff30", Type: godo.DatabaseResourceType}},
+		{urn: "do:droplet:foobar", err: true},
+		{urn: "do:domain:example.com", err: true},
+		{urn: "droplet:4126873", err: true},
+	}
+
+	for _, c := range cases {
+		actual, err := expandTagAssignmentURN(c.urn)
+		if c.err {
+			if err == nil {
+				t.Errorf("expected an error for %q", c.urn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", c.urn, err)
+			continue
+		}
+		if actual != c.expected {
+			t.Errorf("expected %q to be %+v, got %+v", c.urn, c.expected, actual)
+		}
+	}
+}
+
+func testAccCheckDigitalOceanTagAssignmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_tag_assignment" {
+			continue
+		}
+
+		res, err := expandTagAssignmentURN(rs.Primary.Attributes["urn"])
+		if err != nil {
+			return err
+		}
+
+		tags, resp, err := getTaggedResourceTags(context.Background(), client, res)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+			return err
+		}
+		for _, tag := range tags {
+			if strings.EqualFold(tag, rs.Primary.Attributes["tag"]) {
+				return fmt.Errorf("Tag %s is still applied to %s", tag, rs.Primary.Attributes["urn"])
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanTagAssignmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No tag assignment ID is set")
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		res, err := expandTagAssignmentURN(rs.Primary.Attributes["urn"])
+		if err != nil {
+			return err
+		}
+		tags, _, err := getTaggedResourceTags(context.Background(), client, res)
+		if err != nil {
+			return err
+		}
+		for _, tag := range tags {
+			if strings.EqualFold(tag, rs.Primary.Attributes["tag"]) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Tag %s is not applied to %s", rs.Primary.Attributes["tag"], rs.Primary.Attributes["urn"])
+	}
+}
+
+func testAccCheckDigitalOceanTagAssignmentConfig_basic(tagName, volumeName string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_tag" "foobar" {
+  name = "%s"
+}
+
+resource "digitalocean_volume" "foobar" {
+  region = "nyc1"
+  name   = "%s"
+  size   = 1
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "digitalocean_tag_assignment" "foobar" {
+  tag = digitalocean_tag.foobar.name
+  urn = digitalocean_volume.foobar.urn
+}`, tagName, volumeName)
+}
@@ -3,6 +3,8 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
 	"github.com/digitalocean/godo"
@@ -10,6 +12,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("digitalocean_tag", &resource.Sweeper{
+		Name: "digitalocean_tag",
+		F:    testSweepTags,
+	})
+}
+
+func testSweepTags(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*CombinedConfig).godoClient()
+
+	opt := &godo.ListOptions{PerPage: 200}
+	tags, _, err := client.Tags.List(context.Background(), opt)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range tags {
+		if t.Name == "foobar" || strings.HasPrefix(t.Name, testNamePrefix) {
+			log.Printf("Destroying tag %s", t.Name)
+
+			if _, err := client.Tags.Delete(context.Background(), t.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func TestAccDigitalOceanTag_Basic(t *testing.T) {
 	var tag godo.Tag
 
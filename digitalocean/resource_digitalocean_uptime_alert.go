@@ -0,0 +1,238 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanUptimeAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanUptimeAlertCreate,
+		ReadContext:   resourceDigitalOceanUptimeAlertRead,
+		UpdateContext: resourceDigitalOceanUptimeAlertUpdate,
+		DeleteContext: resourceDigitalOceanUptimeAlertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanUptimeAlertImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"check_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the id of the uptime check the alert belongs to",
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"latency",
+					"down",
+					"down_global",
+					"ssl_expiry",
+				}, false),
+			},
+
+			"threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "the latency in milliseconds or the number of days before the certificate expires to alert at",
+			},
+
+			"comparison": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"greater_than",
+					"less_than",
+				}, false),
+				Description: "the comparison operator to use for threshold",
+			},
+
+			"period": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "2m",
+				ValidateFunc: validation.StringInSlice([]string{
+					"2m", "3m", "5m", "10m", "15m", "30m", "1h",
+				}, false),
+				Description: "how long the condition must hold before alerting",
+			},
+
+			"notifications": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "how to notify about the alert, by Slack or email",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slack": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: CaseSensitive,
+										Description:      "The Slack channel to send alerts to",
+										ValidateFunc:     validation.StringIsNotEmpty,
+									},
+									"url": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: CaseSensitive,
+										Description:      "The webhook URL for Slack",
+										ValidateFunc:     validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+						"email": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "List of email addresses to sent notifications to",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+			alertType := diff.Get("type").(string)
+			if alertType != "latency" && alertType != "ssl_expiry" {
+				return nil
+			}
+
+			if !diff.NewValueKnown("threshold") || !diff.NewValueKnown("comparison") {
+				return nil
+			}
+
+			if diff.Get("threshold").(int) == 0 || diff.Get("comparison").(string) == "" {
+				return fmt.Errorf("threshold and comparison are required for %s alerts", alertType)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandUptimeAlert(d *schema.ResourceData) *uptimeAlert {
+	return &uptimeAlert{
+		Name:          d.Get("name").(string),
+		Type:          d.Get("type").(string),
+		Threshold:     d.Get("threshold").(int),
+		Comparison:    d.Get("comparison").(string),
+		Period:        d.Get("period").(string),
+		Notifications: expandAlerts(d.Get("notifications").([]interface{})),
+	}
+}
+
+func resourceDigitalOceanUptimeAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	checkID := d.Get("check_id").(string)
+	opts := expandUptimeAlert(d)
+
+	log.Printf("[DEBUG] Uptime alert create configuration: %#v", opts)
+	alert, _, err := createUptimeAlert(context.Background(), client, checkID, opts)
+	if err != nil {
+		return diag.Errorf("Error creating uptime alert: %s", err)
+	}
+
+	d.SetId(alert.ID)
+	log.Printf("[INFO] Uptime alert created, ID: %s", d.Id())
+
+	return resourceDigitalOceanUptimeAlertRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanUptimeAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	alert, resp, err := getUptimeAlert(context.Background(), client, d.Get("check_id").(string), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Uptime alert (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving uptime alert: %s", err)
+	}
+
+	d.Set("name", alert.Name)
+	d.Set("type", alert.Type)
+	d.Set("threshold", alert.Threshold)
+	d.Set("comparison", alert.Comparison)
+	d.Set("period", alert.Period)
+	if err := d.Set("notifications", flattenAlerts(alert.Notifications)); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Uptime Alert notifications - error: %#v", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanUptimeAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := expandUptimeAlert(d)
+
+	log.Printf("[DEBUG] Uptime alert update configuration: %#v", opts)
+	if _, _, err := updateUptimeAlert(context.Background(), client, d.Get("check_id").(string), d.Id(), opts); err != nil {
+		return diag.Errorf("Error updating uptime alert: %s", err)
+	}
+
+	return resourceDigitalOceanUptimeAlertRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanUptimeAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	log.Printf("[INFO] Deleting uptime alert: %s", d.Id())
+	resp, err := deleteUptimeAlert(context.Background(), client, d.Get("check_id").(string), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error deleting uptime alert: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanUptimeAlertImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		if len(s) != 2 || s[0] == "" || s[1] == "" {
+			return nil, fmt.Errorf("Invalid uptime alert import ID %q, expected check_id,alert_id", d.Id())
+		}
+
+		d.SetId(s[1])
+		d.Set("check_id", s[0])
+	} else {
+		return nil, fmt.Errorf("Must use the ID of the uptime check and the ID of the alert joined with a comma (e.g. `check_id,alert_id`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
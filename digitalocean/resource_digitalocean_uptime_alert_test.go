@@ -0,0 +1,125 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanUptimeAlert_Basic(t *testing.T) {
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanUptimeAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanUptimeAlertConfig_basic, name, name, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_uptime_alert.foobar", "check_id", "digitalocean_uptime_check.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "name", name),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "type", "latency"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "threshold", "300"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "comparison", "greater_than"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "period", "2m"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "notifications.0.email.0", "benny@digitalocean.com"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanUptimeAlertConfig_basic, name, name, 500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_alert.foobar", "threshold", "500"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_uptime_alert.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["digitalocean_uptime_alert.foobar"]
+					return fmt.Sprintf("%s,%s", rs.Primary.Attributes["check_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanUptimeAlert_ThresholdRequired(t *testing.T) {
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanUptimeAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testAccCheckDigitalOceanUptimeAlertConfig_noThreshold, name, name),
+				ExpectError: regexp.MustCompile("threshold and comparison are required for ssl_expiry alerts"),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanUptimeAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_uptime_alert" {
+			continue
+		}
+
+		_, _, err := getUptimeAlert(context.Background(), client, rs.Primary.Attributes["check_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Uptime alert still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccCheckDigitalOceanUptimeAlertConfig_basic = `
+resource "digitalocean_uptime_check" "foobar" {
+  name   = "%s"
+  target = "https://www.example.com"
+}
+
+resource "digitalocean_uptime_alert" "foobar" {
+  check_id   = digitalocean_uptime_check.foobar.id
+  name       = "%s"
+  type       = "latency"
+  threshold  = %d
+  comparison = "greater_than"
+
+  notifications {
+    email = ["benny@digitalocean.com"]
+  }
+}`
+
+const testAccCheckDigitalOceanUptimeAlertConfig_noThreshold = `
+resource "digitalocean_uptime_check" "foobar" {
+  name   = "%s"
+  target = "https://www.example.com"
+}
+
+resource "digitalocean_uptime_alert" "foobar" {
+  check_id = digitalocean_uptime_check.foobar.id
+  name     = "%s"
+  type     = "ssl_expiry"
+
+  notifications {
+    email = ["benny@digitalocean.com"]
+  }
+}`
@@ -0,0 +1,162 @@
+package digitalocean
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDigitalOceanUptimeCheck() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanUptimeCheckCreate,
+		ReadContext:   resourceDigitalOceanUptimeCheckRead,
+		UpdateContext: resourceDigitalOceanUptimeCheckUpdate,
+		DeleteContext: resourceDigitalOceanUptimeCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "name of the uptime check",
+			},
+
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "the endpoint to check, a hostname, IP address or URL",
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "https",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ping",
+					"http",
+					"https",
+				}, false),
+				Description: "the protocol used to check the target",
+			},
+
+			"regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"us_east",
+						"us_west",
+						"eu_west",
+						"se_asia",
+					}, false),
+				},
+				Description: "the regions from which the target is checked",
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func expandUptimeCheck(d *schema.ResourceData) *uptimeCheck {
+	check := &uptimeCheck{
+		Name:    d.Get("name").(string),
+		Type:    d.Get("type").(string),
+		Target:  d.Get("target").(string),
+		Enabled: d.Get("enabled").(bool),
+	}
+
+	if v, ok := d.GetOk("regions"); ok {
+		for _, region := range v.(*schema.Set).List() {
+			check.Regions = append(check.Regions, region.(string))
+		}
+	}
+
+	return check
+}
+
+func resourceDigitalOceanUptimeCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := expandUptimeCheck(d)
+
+	log.Printf("[DEBUG] Uptime check create configuration: %#v", opts)
+	check, _, err := createUptimeCheck(context.Background(), client, opts)
+	if err != nil {
+		return diag.Errorf("Error creating uptime check: %s", err)
+	}
+
+	d.SetId(check.ID)
+	log.Printf("[INFO] Uptime check created, ID: %s", d.Id())
+
+	return resourceDigitalOceanUptimeCheckRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanUptimeCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	check, resp, err := getUptimeCheck(context.Background(), client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Uptime check (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving uptime check: %s", err)
+	}
+
+	d.Set("name", check.Name)
+	d.Set("type", check.Type)
+	d.Set("target", check.Target)
+	d.Set("enabled", check.Enabled)
+	if err := d.Set("regions", check.Regions); err != nil {
+		return diag.Errorf("[DEBUG] Error setting Uptime Check regions - error: %#v", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanUptimeCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	opts := expandUptimeCheck(d)
+
+	log.Printf("[DEBUG] Uptime check update configuration: %#v", opts)
+	if _, _, err := updateUptimeCheck(context.Background(), client, d.Id(), opts); err != nil {
+		return diag.Errorf("Error updating uptime check: %s", err)
+	}
+
+	return resourceDigitalOceanUptimeCheckRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanUptimeCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*CombinedConfig).godoClient()
+
+	log.Printf("[INFO] Deleting uptime check: %s", d.Id())
+	resp, err := deleteUptimeCheck(context.Background(), client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error deleting uptime check: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
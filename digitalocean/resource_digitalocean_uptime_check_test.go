@@ -0,0 +1,139 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("digitalocean_uptime_check", &resource.Sweeper{
+		Name: "digitalocean_uptime_check",
+		F:    testSweepUptimeChecks,
+	})
+}
+
+func testSweepUptimeChecks(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*CombinedConfig).godoClient()
+
+	opt := &godo.ListOptions{PerPage: 200}
+	checks, _, err := listUptimeChecks(context.Background(), client, opt)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range checks {
+		if strings.HasPrefix(c.Name, testNamePrefix) {
+			log.Printf("Destroying uptime check %s", c.Name)
+
+			if _, err := deleteUptimeCheck(context.Background(), client, c.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func TestAccDigitalOceanUptimeCheck_Basic(t *testing.T) {
+	name := randomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanUptimeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanUptimeCheckConfig_basic, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanUptimeCheckExists("digitalocean_uptime_check.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_check.foobar", "name", name),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_check.foobar", "type", "https"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_check.foobar", "target", "https://www.example.com"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_check.foobar", "regions.#", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_check.foobar", "enabled", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanUptimeCheckConfig_basic, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanUptimeCheckExists("digitalocean_uptime_check.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_uptime_check.foobar", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_uptime_check.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanUptimeCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_uptime_check" {
+			continue
+		}
+
+		_, _, err := getUptimeCheck(context.Background(), client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Uptime check still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanUptimeCheckExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Uptime Check ID is set")
+		}
+
+		client := testAccProvider.Meta().(*CombinedConfig).godoClient()
+
+		check, _, err := getUptimeCheck(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if check.ID != rs.Primary.ID {
+			return fmt.Errorf("Uptime check not found")
+		}
+
+		return nil
+	}
+}
+
+const testAccCheckDigitalOceanUptimeCheckConfig_basic = `
+resource "digitalocean_uptime_check" "foobar" {
+  name    = "%s"
+  target  = "https://www.example.com"
+  regions = ["us_east", "eu_west"]
+  enabled = %t
+}`
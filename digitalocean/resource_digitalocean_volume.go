@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -107,6 +108,10 @@ func resourceDigitalOceanVolume() *schema.Resource {
 			"tags": tagsSchema(),
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 
 			// if the new size of the volume is smaller than the old one return an error since
@@ -177,7 +182,7 @@ func resourceDigitalOceanVolumeUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 
 		log.Printf("[DEBUG] Volume resize action id: %d", action.ID)
-		if err = waitForAction(client, action); err != nil {
+		if err = waitForActionWithTimeout(ctx, meta, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.Errorf(
 				"Error waiting for resize volume (%s) to finish: %s", id, err)
 		}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -17,6 +19,9 @@ func resourceDigitalOceanVolumeAttachment() *schema.Resource {
 		CreateContext: resourceDigitalOceanVolumeAttachmentCreate,
 		ReadContext:   resourceDigitalOceanVolumeAttachmentRead,
 		DeleteContext: resourceDigitalOceanVolumeAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanVolumeAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"droplet_id": {
@@ -33,6 +38,11 @@ func resourceDigitalOceanVolumeAttachment() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -50,7 +60,7 @@ func resourceDigitalOceanVolumeAttachmentCreate(ctx context.Context, d *schema.R
 	if volume.DropletIDs == nil || len(volume.DropletIDs) == 0 || volume.DropletIDs[0] != dropletId {
 
 		// Only one volume can be attached at one time to a single droplet.
-		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 
 			log.Printf("[DEBUG] Attaching Volume (%s) to Droplet (%d)", volumeId, dropletId)
 			action, _, err := client.StorageActions.Attach(context.Background(), volumeId, dropletId)
@@ -65,7 +75,7 @@ func resourceDigitalOceanVolumeAttachmentCreate(ctx context.Context, d *schema.R
 			}
 
 			log.Printf("[DEBUG] Volume attach action id: %d", action.ID)
-			if err = waitForAction(client, action); err != nil {
+			if err = waitForActionWithTimeout(ctx, meta, action, d.Timeout(schema.TimeoutCreate)); err != nil {
 				return resource.NonRetryableError(
 					fmt.Errorf("[DEBUG] Error waiting for attach volume (%s) to Droplet (%d) to finish: %s", volumeId, dropletId, err))
 			}
@@ -116,7 +126,7 @@ func resourceDigitalOceanVolumeAttachmentDelete(ctx context.Context, d *schema.R
 	volumeId := d.Get("volume_id").(string)
 
 	// Only one volume can be detached at one time to a single droplet.
-	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 
 		log.Printf("[DEBUG] Detaching Volume (%s) from Droplet (%d)", volumeId, dropletId)
 		action, _, err := client.StorageActions.DetachByDropletID(context.Background(), volumeId, dropletId)
@@ -131,7 +141,7 @@ func resourceDigitalOceanVolumeAttachmentDelete(ctx context.Context, d *schema.R
 		}
 
 		log.Printf("[DEBUG] Volume detach action id: %d", action.ID)
-		if err = waitForAction(client, action); err != nil {
+		if err = waitForActionWithTimeout(ctx, meta, action, d.Timeout(schema.TimeoutDelete)); err != nil {
 			return resource.NonRetryableError(
 				fmt.Errorf("Error waiting for detach volume (%s) from Droplet (%d) to finish: %s", volumeId, dropletId, err))
 		}
@@ -145,3 +155,21 @@ func resourceDigitalOceanVolumeAttachmentDelete(ctx context.Context, d *schema.R
 
 	return nil
 }
+
+func resourceDigitalOceanVolumeAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("Must use the ID of the Droplet and the ID of the volume joined with a comma (e.g. `droplet_id,volume_id`)")
+	}
+
+	dropletId, err := strconv.Atoi(s[0])
+	if err != nil || s[1] == "" {
+		return nil, fmt.Errorf("Invalid volume attachment import ID %q, expected droplet_id,volume_id", d.Id())
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%d-%s-", dropletId, s[1])))
+	d.Set("droplet_id", dropletId)
+	d.Set("volume_id", s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
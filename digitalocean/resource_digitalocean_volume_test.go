@@ -50,7 +50,7 @@ func testSweepVolumes(region string) error {
 					return fmt.Errorf("Error resizing volume (%s): %s", v.ID, err)
 				}
 
-				if err = waitForAction(client, action); err != nil {
+				if err = waitForAction(context.Background(), meta, action); err != nil {
 					return fmt.Errorf(
 						"Error waiting for volume (%s): %s", v.ID, err)
 				}
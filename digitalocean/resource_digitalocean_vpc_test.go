@@ -3,12 +3,55 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
 	"testing"
 
+	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func init() {
+	resource.AddTestSweepers("digitalocean_vpc", &resource.Sweeper{
+		Name: "digitalocean_vpc",
+		F:    testSweepVPCs,
+		Dependencies: []string{
+			"digitalocean_droplet",
+			"digitalocean_database_cluster",
+			"digitalocean_kubernetes_cluster",
+			"digitalocean_loadbalancer",
+		},
+	})
+}
+
+func testSweepVPCs(region string) error {
+	meta, err := sharedConfigForRegion(region)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*CombinedConfig).godoClient()
+
+	opt := &godo.ListOptions{PerPage: 200}
+	vpcs, _, err := client.VPCs.List(context.Background(), opt)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range vpcs {
+		if strings.HasPrefix(v.Name, testNamePrefix) {
+			log.Printf("Destroying VPC %s", v.Name)
+
+			if _, err := client.VPCs.Delete(context.Background(), v.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func TestAccDigitalOceanVPC_Basic(t *testing.T) {
 	vpcName := randomTestName()
 	vpcDesc := "A description for the VPC"
@@ -0,0 +1,105 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// listSnapshots returns the snapshots of resourceType, droplet or volume.
+func listSnapshots(ctx context.Context, client *godo.Client, resourceType string) ([]godo.Snapshot, error) {
+	list := client.Snapshots.ListDroplet
+	if resourceType == "volume" {
+		list = client.Snapshots.ListVolume
+	}
+
+	items, err := listAllPages(ctx, func(ctx context.Context, opts *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		snapshots, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		items := make([]interface{}, len(snapshots))
+		for i, snapshot := range snapshots {
+			items[i] = snapshot
+		}
+		return items, resp, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving %s snapshots: %s", resourceType, err)
+	}
+
+	snapshots := make([]godo.Snapshot, len(items))
+	for i, item := range items {
+		snapshots[i] = item.(godo.Snapshot)
+	}
+
+	return snapshots, nil
+}
+
+// snapshotRetention selects the snapshots a retention policy applies to and
+// the ones it expires.
+type snapshotRetention struct {
+	namePrefix string
+	tag        string
+	// keepLast is the number of most recent snapshots always kept, none
+	// when zero.
+	keepLast int
+	// maxAge is the age after which the snapshots not kept by keepLast
+	// expire. They all expire when it is zero.
+	maxAge time.Duration
+}
+
+func (r *snapshotRetention) matches(snapshot godo.Snapshot) bool {
+	if r.namePrefix != "" && !strings.HasPrefix(snapshot.Name, r.namePrefix) {
+		return false
+	}
+	if r.tag != "" && !stringInSlice(r.tag, snapshot.Tags) {
+		return false
+	}
+	return true
+}
+
+// apply returns the snapshots matching the policy that it keeps and the ones
+// it expires at now, both from the most to the least recent. The snapshots
+// with a creation date that can not be parsed are always kept, after the
+// others.
+func (r *snapshotRetention) apply(snapshots []godo.Snapshot, now time.Time) ([]godo.Snapshot, []godo.Snapshot) {
+	type datedSnapshot struct {
+		snapshot godo.Snapshot
+		created  time.Time
+	}
+
+	var kept, expired, undated []godo.Snapshot
+	var dated []datedSnapshot
+	for _, snapshot := range snapshots {
+		if !r.matches(snapshot) {
+			continue
+		}
+
+		created, err := time.Parse(time.RFC3339, snapshot.Created)
+		if err != nil {
+			undated = append(undated, snapshot)
+			continue
+		}
+		dated = append(dated, datedSnapshot{snapshot: snapshot, created: created})
+	}
+
+	sort.SliceStable(dated, func(i, j int) bool {
+		return dated[i].created.After(dated[j].created)
+	})
+
+	for i, s := range dated {
+		if i < r.keepLast || (r.maxAge > 0 && now.Sub(s.created) <= r.maxAge) {
+			kept = append(kept, s.snapshot)
+		} else {
+			expired = append(expired, s.snapshot)
+		}
+	}
+
+	return append(kept, undated...), expired
+}
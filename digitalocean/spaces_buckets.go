@@ -1,7 +1,9 @@
 package digitalocean
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +14,37 @@ var (
 	SpacesRegions = []string{"ams3", "fra1", "nyc3", "sfo2", "sfo3", "sgp1"}
 )
 
+// setSpacesDefaultRegion sets the region of a Spaces resource which does not
+// set one to the spaces_default_region of the provider, or to fallback if it
+// is not set either.
+func setSpacesDefaultRegion(fallback string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Get("region").(string) != "" {
+			return nil
+		}
+
+		region := meta.(*CombinedConfig).spacesDefaultRegion
+		if region == "" {
+			region = fallback
+		}
+		if region == "" {
+			return fmt.Errorf("region must be set when spaces_default_region is not set in the provider")
+		}
+
+		return d.SetNew("region", strings.ToLower(region))
+	}
+}
+
+// isSpacesRegion reports whether slug is the one of a region supporting Spaces.
+func isSpacesRegion(slug string) bool {
+	for _, region := range SpacesRegions {
+		if strings.EqualFold(region, slug) {
+			return true
+		}
+	}
+	return false
+}
+
 type bucketMetadataStruct struct {
 	name   string
 	region string
@@ -3,8 +3,12 @@ package digitalocean
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
 )
 
 func sshKeySchema() map[string]*schema.Schema {
@@ -61,7 +65,44 @@ func getDigitalOceanSshKeys(meta interface{}, extra map[string]interface{}) ([]i
 		opts.Page = page + 1
 	}
 
-	return keyList, nil
+	return filterDigitalOceanSshKeys(keyList, extra)
+}
+
+// filterDigitalOceanSshKeys returns the keys matching the name_regex and
+// fingerprints arguments of the digitalocean_ssh_keys data source.
+func filterDigitalOceanSshKeys(keys []interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	var nameRegex *regexp.Regexp
+	if v, _ := extra["name_regex"].(string); v != "" {
+		var err error
+		if nameRegex, err = regexp.Compile(v); err != nil {
+			return nil, fmt.Errorf("Error parsing name_regex: %s", err)
+		}
+	}
+
+	fingerprints := map[string]bool{}
+	if v, ok := extra["fingerprints"].(*schema.Set); ok {
+		for _, fingerprint := range v.List() {
+			fingerprints[strings.ToLower(fingerprint.(string))] = true
+		}
+	}
+
+	if nameRegex == nil && len(fingerprints) == 0 {
+		return keys, nil
+	}
+
+	var matching []interface{}
+	for _, item := range keys {
+		key := item.(godo.Key)
+		if nameRegex != nil && !nameRegex.MatchString(key.Name) {
+			continue
+		}
+		if len(fingerprints) > 0 && !fingerprints[strings.ToLower(key.Fingerprint)] {
+			continue
+		}
+		matching = append(matching, key)
+	}
+
+	return matching, nil
 }
 
 func flattenDigitalOceanSshKey(rawSshKey, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
@@ -76,3 +117,36 @@ func flattenDigitalOceanSshKey(rawSshKey, meta interface{}, extra map[string]int
 
 	return flattenedSshKey, nil
 }
+
+func summarizeDigitalOceanSshKeys(records []map[string]interface{}) (map[string]interface{}, error) {
+	ids := make([]interface{}, len(records))
+	fingerprints := make([]interface{}, len(records))
+	for i, record := range records {
+		ids[i] = record["id"]
+		fingerprints[i] = record["fingerprint"]
+	}
+
+	return map[string]interface{}{
+		"ids":                   ids,
+		"matching_fingerprints": fingerprints,
+	}, nil
+}
+
+// sshKeyFingerprint returns the fingerprint DigitalOcean gives to publicKey,
+// the MD5 hash of the key in colon-separated hexadecimal.
+func sshKeyFingerprint(publicKey string) (string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", err
+	}
+
+	return ssh.FingerprintLegacyMD5(key), nil
+}
+
+func validateSSHPublicKey(v interface{}, k string) ([]string, []error) {
+	if _, err := sshKeyFingerprint(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a public key in the OpenSSH authorized_keys format: %s", k, err)}
+	}
+
+	return nil, nil
+}
@@ -31,6 +31,8 @@ func sharedConfigForRegion(region string) (interface{}, error) {
 		Token:             os.Getenv("DIGITALOCEAN_TOKEN"),
 		APIEndpoint:       apiEndpoint,
 		SpacesAPIEndpoint: spacesEndpoint,
+		AccessID:          os.Getenv("SPACES_ACCESS_KEY_ID"),
+		SecretKey:         os.Getenv("SPACES_SECRET_ACCESS_KEY"),
 	}
 
 	// configures a default client for the region, using the above env vars
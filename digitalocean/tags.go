@@ -135,3 +135,38 @@ func flattenTags(tags []string) *schema.Set {
 
 	return flattenedTags
 }
+
+// flattenTagResourceCounts returns the number of resources of each type a tag
+// is applied to. The API leaves out the types the tag was never applied to.
+func flattenTagResourceCounts(resources *godo.TaggedResources) map[string]interface{} {
+	counts := map[string]interface{}{
+		"total_resource_count":   0,
+		"droplets_count":         0,
+		"images_count":           0,
+		"volumes_count":          0,
+		"volume_snapshots_count": 0,
+		"databases_count":        0,
+	}
+	if resources == nil {
+		return counts
+	}
+
+	counts["total_resource_count"] = resources.Count
+	if resources.Droplets != nil {
+		counts["droplets_count"] = resources.Droplets.Count
+	}
+	if resources.Images != nil {
+		counts["images_count"] = resources.Images.Count
+	}
+	if resources.Volumes != nil {
+		counts["volumes_count"] = resources.Volumes.Count
+	}
+	if resources.VolumeSnapshots != nil {
+		counts["volume_snapshots_count"] = resources.VolumeSnapshots.Count
+	}
+	if resources.Databases != nil {
+		counts["databases_count"] = resources.Databases.Count
+	}
+
+	return counts
+}
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/digitalocean/godo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -137,3 +138,27 @@ func TestFlattenTags(t *testing.T) {
 		t.Fatalf("incorrect expected length of flattened tags")
 	}
 }
+
+func TestFlattenTagResourceCounts(t *testing.T) {
+	counts := flattenTagResourceCounts(&godo.TaggedResources{
+		Count:     3,
+		Droplets:  &godo.TaggedDropletsResources{Count: 2},
+		Databases: &godo.TaggedDatabasesResources{Count: 1},
+	})
+
+	expected := map[string]interface{}{
+		"total_resource_count":   3,
+		"droplets_count":         2,
+		"images_count":           0,
+		"volumes_count":          0,
+		"volume_snapshots_count": 0,
+		"databases_count":        1,
+	}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("expected %v, got %v", expected, counts)
+	}
+
+	if counts := flattenTagResourceCounts(nil); counts["total_resource_count"] != 0 {
+		t.Errorf("expected a tag without resources to have a count of 0, got %v", counts)
+	}
+}
@@ -0,0 +1,95 @@
+package digitalocean
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// newTokenSource returns the source of the API token. A token set directly is
+// used as is. Tokens read from token_file or printed by token_command are
+// cached for tokenCacheTTL, so tokens rotated by a secret manager are picked
+// up without restarting Terraform.
+func (c *Config) newTokenSource() oauth2.TokenSource {
+	var read func() (string, error)
+	switch {
+	case c.Token != "" || (c.TokenFile == "" && c.TokenCommand == ""):
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: c.Token})
+	case c.TokenFile != "":
+		read = func() (string, error) { return readTokenFile(c.TokenFile) }
+	default:
+		read = func() (string, error) { return runTokenCommand(c.TokenCommand) }
+	}
+
+	return oauth2.ReuseTokenSource(nil, &cachedTokenSource{
+		read: read,
+		ttl:  c.TokenCacheTTL,
+	})
+}
+
+// cachedTokenSource reads a token which expires after ttl, oauth2's
+// ReuseTokenSource only reads it again once it has expired.
+type cachedTokenSource struct {
+	read func() (string, error)
+	ttl  time.Duration
+}
+
+func (s *cachedTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	t := &oauth2.Token{AccessToken: token}
+	if s.ttl > 0 {
+		t.Expiry = time.Now().Add(s.ttl)
+	}
+
+	return t, nil
+}
+
+func readTokenFile(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Error reading token_file: %s", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("Error reading token_file: %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// runTokenCommand runs command with the shell and returns what it prints to
+// its standard output.
+func runTokenCommand(command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("Error running token_command: %s: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(string(output))
+	if token == "" {
+		return "", fmt.Errorf("Error running token_command: no token printed")
+	}
+
+	return token, nil
+}
@@ -0,0 +1,101 @@
+package digitalocean
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTokenSource_Static(t *testing.T) {
+	config := &Config{Token: "static", TokenFile: "/nonexistent"}
+
+	token, err := config.newTokenSource().Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token.AccessToken != "static" {
+		t.Errorf("expected token to take precedence over token_file, got %q", token.AccessToken)
+	}
+}
+
+func TestTokenSource_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(path, []byte("first\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	source := (&Config{TokenFile: path, TokenCacheTTL: time.Hour}).newTokenSource()
+
+	token, err := source.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token.AccessToken != "first" {
+		t.Errorf("expected token %q, got %q", "first", token.AccessToken)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("second\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err = source.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token.AccessToken != "first" {
+		t.Errorf("expected the cached token %q, got %q", "first", token.AccessToken)
+	}
+
+}
+
+func TestTokenSource_FileExpired(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(path, []byte("first\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// oauth2 refreshes tokens expiring within the next 10 seconds, so a TTL
+	// of a second reads the file on every request.
+	source := (&Config{TokenFile: path, TokenCacheTTL: time.Second}).newTokenSource()
+	if _, err := source.Token(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("second\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := source.Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token.AccessToken != "second" {
+		t.Errorf("expected the rotated token %q, got %q", "second", token.AccessToken)
+	}
+}
+
+func TestTokenSource_FileEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(path, []byte("\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := (&Config{TokenFile: path}).newTokenSource().Token(); err == nil {
+		t.Error("expected an error for an empty token_file")
+	}
+}
+
+func TestTokenSource_Command(t *testing.T) {
+	token, err := (&Config{TokenCommand: "echo from-helper"}).newTokenSource().Token()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token.AccessToken != "from-helper" {
+		t.Errorf("expected token %q, got %q", "from-helper", token.AccessToken)
+	}
+
+	if _, err := (&Config{TokenCommand: "exit 1"}).newTokenSource().Token(); err == nil {
+		t.Error("expected an error when token_command fails")
+	}
+}
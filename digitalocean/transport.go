@@ -0,0 +1,413 @@
+package digitalocean
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	retryBackoffExponential = "exponential"
+	retryBackoffConstant    = "constant"
+)
+
+// rateLimitTransport throttles the requests made to the API to a number of
+// requests per second and retries the ones rejected with a 429 Too Many
+// Requests response or failing because of a transient error.
+type rateLimitTransport struct {
+	transport http.RoundTripper
+
+	// interval is the minimum time between two requests, no throttling
+	// applies if it is zero.
+	interval time.Duration
+
+	retryMax     int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	retryBackoff string
+
+	// jitter randomizes the computed backoffs so that clients rejected at
+	// the same time do not retry at the same time.
+	jitter func(time.Duration) time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newRateLimitTransport(transport http.RoundTripper, requestsPerSecond float64, retryMax int, retryWaitMin, retryWaitMax time.Duration, retryBackoff string) *rateLimitTransport {
+	t := &rateLimitTransport{
+		transport:    transport,
+		retryMax:     retryMax,
+		retryWaitMin: retryWaitMin,
+		retryWaitMax: retryWaitMax,
+		retryBackoff: retryBackoff,
+		jitter:       halfJitter,
+	}
+
+	if requestsPerSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+
+	return t
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := t.wait(req, t.reserve()); err != nil {
+			return nil, err
+		}
+
+		// The body has been consumed by the previous attempt.
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		resp, err := t.transport.RoundTrip(req)
+		reason := retryReason(req, resp, err)
+		if reason == "" || attempt >= t.retryMax {
+			return resp, err
+		}
+
+		// Requests whose body can not be read again are not retried.
+		if req.Body != nil && req.GetBody == nil {
+			return resp, err
+		}
+
+		backoff := t.backoff(attempt, resp)
+		log.Printf("[WARN] DigitalOcean API %s, retrying %s %s in %s (%d/%d)", reason, req.Method, req.URL, backoff, attempt+1, t.retryMax)
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := t.wait(req, backoff); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryReason returns why the request should be retried, or an empty string
+// if it should not. Requests rejected by the rate limit or by an unavailable
+// server have not been processed and are always retried. Requests failing
+// with another transient error might have been, they are only retried if
+// they are idempotent.
+func retryReason(req *http.Request, resp *http.Response, err error) string {
+	if err != nil {
+		if req.Context().Err() == nil && isIdempotent(req) && isConnectionError(err) {
+			return fmt.Sprintf("connection error (%s)", err)
+		}
+		return ""
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return "rate limit exceeded"
+	case http.StatusServiceUnavailable:
+		return "unavailable"
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		if isIdempotent(req) {
+			return fmt.Sprintf("server error (%s)", resp.Status)
+		}
+	}
+
+	return ""
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isConnectionError reports whether err is caused by the connection being
+// closed or reset by the server or one of the proxies in front of it.
+func isConnectionError(err error) bool {
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+// reserve returns how long to wait before sending the next request to keep
+// the requests at least interval apart.
+func (t *rateLimitTransport) reserve() time.Duration {
+	if t.interval == 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+
+	return wait
+}
+
+// backoff returns how long to wait before retrying a request.
+// The API reports when the rate limit resets, the wait is capped at
+// retryWaitMax nonetheless.
+func (t *rateLimitTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := t.retryWaitMin
+	if t.retryBackoff != retryBackoffConstant {
+		// The wait is capped before converting it to a duration, which
+		// overflows after about 30 attempts of a one second wait.
+		w := float64(t.retryWaitMin) * math.Pow(2, float64(attempt))
+		if w > float64(t.retryWaitMax) {
+			w = float64(t.retryWaitMax)
+		}
+		wait = time.Duration(w)
+	}
+	if t.jitter != nil {
+		wait = t.jitter(wait)
+	}
+
+	// There is no response when the request failed because of the connection.
+	var header http.Header
+	if resp != nil {
+		header = resp.Header
+	}
+
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			if time.Duration(seconds) > t.retryWaitMax/time.Second {
+				wait = t.retryWaitMax
+			} else {
+				wait = time.Duration(seconds) * time.Second
+			}
+		}
+	} else if v := header.Get("RateLimit-Reset"); v != "" {
+		if reset, err := strconv.ParseInt(v, 10, 64); err == nil {
+			if untilReset := time.Until(time.Unix(reset, 0)); untilReset > wait {
+				wait = untilReset
+			}
+		}
+	}
+
+	if wait < t.retryWaitMin {
+		wait = t.retryWaitMin
+	}
+	if wait > t.retryWaitMax {
+		wait = t.retryWaitMax
+	}
+
+	return wait
+}
+
+// halfJitter returns a random duration between d/2 and d.
+func halfJitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+func (t *rateLimitTransport) wait(req *http.Request, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// debugLogBodyLimit is the maximum size of the bodies logged by
+// debugLogTransport, larger bodies are truncated.
+const debugLogBodyLimit = 64 * 1024
+
+var (
+	// debugLogRedactedHeaders are the headers whose values are never logged.
+	debugLogRedactedHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"X-Amz-Security-Token",
+	}
+
+	// debugLogRedactedFields are the fields of JSON bodies whose values are
+	// never logged, in addition to the ones whose name contains one of
+	// debugLogRedactedSubstrings or ends with one of debugLogRedactedSuffixes.
+	// Objects and arrays are redacted as a whole, e.g. the auths of Docker
+	// credentials or the service key of partner attachments.
+	debugLogRedactedFields = map[string]bool{
+		"uri":               true,
+		"private_uri":       true,
+		"user_data":         true,
+		"certificate_chain": true,
+		"leaf_certificate":  true,
+		"auths":             true,
+		"auth":              true,
+		"auth_key":          true,
+		"signature":         true,
+		"service_key":       true,
+	}
+	debugLogRedactedSubstrings = []string{"password", "secret", "token", "private_key"}
+	// The credentials of Kubernetes clusters end with _data, e.g.
+	// client_key_data and client_certificate_data.
+	debugLogRedactedSuffixes = []string{"_data"}
+)
+
+// debugLogTransport logs the requests and responses going through it with
+// their headers and bodies, redacting the credentials they contain.
+type debugLogTransport struct {
+	name      string
+	transport http.RoundTripper
+}
+
+func newDebugLogTransport(name string, transport http.RoundTripper) *debugLogTransport {
+	return &debugLogTransport{
+		name:      name,
+		transport: transport,
+	}
+}
+
+func (t *debugLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.GetBody != nil {
+		if r, err := req.GetBody(); err == nil {
+			body, _ = ioutil.ReadAll(io.LimitReader(r, debugLogBodyLimit+1))
+			r.Close()
+		}
+	}
+	log.Printf("[DEBUG] %s API Request: %s %s\n%s", t.name, req.Method, req.URL, debugLogMessage(req.Header, body))
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		log.Printf("[DEBUG] %s API Request %s %s failed: %s", t.name, req.Method, req.URL, err)
+		return resp, err
+	}
+
+	// Only the logged part of the body is buffered, the rest is still read
+	// from the connection.
+	body, err = ioutil.ReadAll(io.LimitReader(resp.Body, debugLogBodyLimit+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+
+	log.Printf("[DEBUG] %s API Response: %s %s: %s\n%s", t.name, req.Method, req.URL, resp.Status, debugLogMessage(resp.Header, body))
+
+	return resp, nil
+}
+
+// debugLogMessage formats headers and a body with their credentials redacted.
+func debugLogMessage(header http.Header, body []byte) string {
+	header = header.Clone()
+	for _, name := range debugLogRedactedHeaders {
+		if header.Get(name) != "" {
+			header.Set(name, "REDACTED")
+		}
+	}
+
+	var b strings.Builder
+	header.Write(&b)
+
+	if len(body) == 0 {
+		return b.String()
+	}
+
+	b.WriteString("\n")
+	if len(body) > debugLogBodyLimit {
+		fmt.Fprintf(&b, "<body of more than %d bytes not logged>", debugLogBodyLimit)
+		return b.String()
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err != nil {
+			b.WriteString("<malformed JSON body not logged>")
+			break
+		}
+		redacted, _ := json.MarshalIndent(redactDebugLogValue(v), "", "  ")
+		b.Write(redacted)
+	case strings.HasSuffix(mediaType, "/xml") || strings.HasPrefix(mediaType, "text/"):
+		b.Write(body)
+	default:
+		fmt.Fprintf(&b, "<%d bytes of %q not logged>", len(body), mediaType)
+	}
+
+	return b.String()
+}
+
+func redactDebugLogValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// The values of the environment variables of apps can be secrets.
+		if t, ok := v["type"].(string); ok && strings.EqualFold(t, "SECRET") {
+			if _, ok := v["value"]; ok {
+				v["value"] = "REDACTED"
+			}
+		}
+
+		for key, value := range v {
+			if isRedactedDebugLogField(key) {
+				v[key] = "REDACTED"
+			} else {
+				v[key] = redactDebugLogValue(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactDebugLogValue(value)
+		}
+	}
+
+	return v
+}
+
+func isRedactedDebugLogField(name string) bool {
+	name = strings.ToLower(name)
+	if debugLogRedactedFields[name] {
+		return true
+	}
+
+	for _, s := range debugLogRedactedSubstrings {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	for _, s := range debugLogRedactedSuffixes {
+		if strings.HasSuffix(name, s) {
+			return true
+		}
+	}
+
+	return false
+}
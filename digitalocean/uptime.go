@@ -0,0 +1,162 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+)
+
+const (
+	uptimeChecksBasePath = "/v2/uptime/checks"
+	uptimeCheckPath      = uptimeChecksBasePath + "/%s"
+	uptimeAlertsPath     = uptimeCheckPath + "/alerts"
+	uptimeAlertPath      = uptimeAlertsPath + "/%s"
+)
+
+// uptimeCheck probes an endpoint from one or more regions.
+type uptimeCheck struct {
+	ID      string   `json:"id,omitempty"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Target  string   `json:"target"`
+	Regions []string `json:"regions,omitempty"`
+	Enabled bool     `json:"enabled"`
+}
+
+type uptimeCheckRoot struct {
+	Check *uptimeCheck `json:"check"`
+}
+
+type uptimeChecksRoot struct {
+	Checks []uptimeCheck `json:"checks"`
+}
+
+// uptimeAlert notifies about an uptime check going down, responding slowly
+// or serving an expiring certificate. Notifications use the same format as
+// the ones of monitoring alert policies.
+type uptimeAlert struct {
+	ID            string      `json:"id,omitempty"`
+	Name          string      `json:"name"`
+	Type          string      `json:"type"`
+	Threshold     int         `json:"threshold,omitempty"`
+	Comparison    string      `json:"comparison,omitempty"`
+	Notifications godo.Alerts `json:"notifications"`
+	Period        string      `json:"period"`
+}
+
+type uptimeAlertRoot struct {
+	Alert *uptimeAlert `json:"alert"`
+}
+
+// createUptimeCheck creates an uptime check.
+func createUptimeCheck(ctx context.Context, client *godo.Client, check *uptimeCheck) (*uptimeCheck, *godo.Response, error) {
+	return doUptimeCheckRequest(ctx, client, http.MethodPost, uptimeChecksBasePath, check)
+}
+
+// listUptimeChecks lists the uptime checks of the account.
+func listUptimeChecks(ctx context.Context, client *godo.Client, opt *godo.ListOptions) ([]uptimeCheck, *godo.Response, error) {
+	path := uptimeChecksBasePath
+	if opt != nil {
+		path = fmt.Sprintf("%s?page=%d&per_page=%d", path, opt.Page, opt.PerPage)
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(uptimeChecksRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Checks, resp, nil
+}
+
+// getUptimeCheck retrieves an uptime check.
+func getUptimeCheck(ctx context.Context, client *godo.Client, id string) (*uptimeCheck, *godo.Response, error) {
+	return doUptimeCheckRequest(ctx, client, http.MethodGet, fmt.Sprintf(uptimeCheckPath, id), nil)
+}
+
+// updateUptimeCheck replaces the settings of an uptime check.
+func updateUptimeCheck(ctx context.Context, client *godo.Client, id string, check *uptimeCheck) (*uptimeCheck, *godo.Response, error) {
+	return doUptimeCheckRequest(ctx, client, http.MethodPut, fmt.Sprintf(uptimeCheckPath, id), check)
+}
+
+// deleteUptimeCheck deletes an uptime check along with its alerts.
+func deleteUptimeCheck(ctx context.Context, client *godo.Client, id string) (*godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf(uptimeCheckPath, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+func doUptimeCheckRequest(ctx context.Context, client *godo.Client, method, path string, check *uptimeCheck) (*uptimeCheck, *godo.Response, error) {
+	var body interface{}
+	if check != nil {
+		body = check
+	}
+
+	req, err := client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(uptimeCheckRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Check, resp, nil
+}
+
+// createUptimeAlert creates an alert for an uptime check.
+func createUptimeAlert(ctx context.Context, client *godo.Client, checkID string, alert *uptimeAlert) (*uptimeAlert, *godo.Response, error) {
+	return doUptimeAlertRequest(ctx, client, http.MethodPost, fmt.Sprintf(uptimeAlertsPath, checkID), alert)
+}
+
+// getUptimeAlert retrieves an alert of an uptime check.
+func getUptimeAlert(ctx context.Context, client *godo.Client, checkID, id string) (*uptimeAlert, *godo.Response, error) {
+	return doUptimeAlertRequest(ctx, client, http.MethodGet, fmt.Sprintf(uptimeAlertPath, checkID, id), nil)
+}
+
+// updateUptimeAlert replaces the settings of an alert of an uptime check.
+func updateUptimeAlert(ctx context.Context, client *godo.Client, checkID, id string, alert *uptimeAlert) (*uptimeAlert, *godo.Response, error) {
+	return doUptimeAlertRequest(ctx, client, http.MethodPut, fmt.Sprintf(uptimeAlertPath, checkID, id), alert)
+}
+
+// deleteUptimeAlert deletes an alert of an uptime check.
+func deleteUptimeAlert(ctx context.Context, client *godo.Client, checkID, id string) (*godo.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf(uptimeAlertPath, checkID, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(ctx, req, nil)
+}
+
+func doUptimeAlertRequest(ctx context.Context, client *godo.Client, method, path string, alert *uptimeAlert) (*uptimeAlert, *godo.Response, error) {
+	var body interface{}
+	if alert != nil {
+		body = alert
+	}
+
+	req, err := client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(uptimeAlertRoot)
+	resp, err := client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Alert, resp, nil
+}
@@ -0,0 +1,369 @@
+package digitalocean
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	vcrModeRecord = "record"
+	vcrModeReplay = "replay"
+)
+
+// vcrMode returns whether the acceptance tests record the requests they make
+// or replay the recorded ones instead of sending them, as set by the
+// DIGITALOCEAN_VCR_MODE environment variable. It is empty when they do
+// neither.
+func vcrMode() string {
+	return os.Getenv("DIGITALOCEAN_VCR_MODE")
+}
+
+// vcrCassettePath returns the path of the file the requests are recorded to,
+// set by the DIGITALOCEAN_VCR_CASSETTE environment variable.
+func vcrCassettePath() string {
+	if path := os.Getenv("DIGITALOCEAN_VCR_CASSETTE"); path != "" {
+		return path
+	}
+	return filepath.Join("testdata", "vcr", "cassette.json")
+}
+
+// vcrInteraction is a recorded request and the response it got.
+type vcrInteraction struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	RequestBody string      `json:"request_body,omitempty"`
+	StatusCode  int         `json:"status_code"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        string      `json:"body,omitempty"`
+}
+
+func (i *vcrInteraction) key() string {
+	return i.Method + " " + i.URL + "\n" + i.RequestBody
+}
+
+// vcrCassette holds the interactions recorded by every client configured
+// during a test run.
+type vcrCassette struct {
+	mode string
+	path string
+
+	mu           sync.Mutex
+	interactions []*vcrInteraction
+	// recorded are the requests recorded during this run, the interactions
+	// loaded from the file for them are replaced.
+	recorded map[string]bool
+	// replayed counts the interactions replayed for each request.
+	replayed map[string]int
+}
+
+func newVCRCassette(mode, path string) (*vcrCassette, error) {
+	if mode != vcrModeRecord && mode != vcrModeReplay {
+		return nil, fmt.Errorf("DIGITALOCEAN_VCR_MODE must be %q or %q, got %q", vcrModeRecord, vcrModeReplay, mode)
+	}
+
+	c := &vcrCassette{
+		mode:     mode,
+		path:     path,
+		recorded: map[string]bool{},
+		replayed: map[string]int{},
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) && mode == vcrModeRecord {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading the VCR cassette: %s", err)
+	}
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		return nil, fmt.Errorf("error parsing the VCR cassette %s: %s", path, err)
+	}
+
+	return c, nil
+}
+
+// transport returns a transport recording the requests sent with transport
+// or replaying them, depending on the mode of the cassette.
+func (c *vcrCassette) transport(transport http.RoundTripper) http.RoundTripper {
+	return &vcrTransport{
+		cassette:  c,
+		transport: transport,
+	}
+}
+
+// record adds an interaction to the cassette and saves it, so that the
+// interactions recorded by a run interrupted midway are not lost.
+func (c *vcrCassette) record(interaction *vcrInteraction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := interaction.key()
+	if !c.recorded[key] {
+		c.recorded[key] = true
+
+		interactions := c.interactions[:0]
+		for _, i := range c.interactions {
+			if i.key() != key {
+				interactions = append(interactions, i)
+			}
+		}
+		c.interactions = interactions
+	}
+	c.interactions = append(c.interactions, interaction)
+
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(c.path, data, 0644)
+}
+
+// replay returns the next interaction recorded for a request. The resources
+// being created or deleted are polled until they are ready, the last
+// interaction recorded for a request is replayed once the others have been.
+func (c *vcrCassette) replay(key string) (*vcrInteraction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var matches []*vcrInteraction
+	for _, i := range c.interactions {
+		if i.key() == key {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no interaction recorded in %s for %s, record the test again with DIGITALOCEAN_VCR_MODE=%s", c.path, strings.SplitN(key, "\n", 2)[0], vcrModeRecord)
+	}
+
+	n := c.replayed[key]
+	c.replayed[key]++
+	if n >= len(matches) {
+		n = len(matches) - 1
+	}
+
+	return matches[n], nil
+}
+
+type vcrTransport struct {
+	cassette  *vcrCassette
+	transport http.RoundTripper
+}
+
+func (t *vcrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	interaction := &vcrInteraction{
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		RequestBody: string(body),
+	}
+
+	if t.cassette.mode == vcrModeReplay {
+		recorded, err := t.cassette.replay(interaction.key())
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Header.Clone(),
+			Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	interaction.StatusCode = resp.StatusCode
+	interaction.Header = resp.Header.Clone()
+	interaction.Header.Del("Set-Cookie")
+	interaction.Body = string(respBody)
+
+	if err := t.cassette.record(interaction); err != nil {
+		return nil, fmt.Errorf("error recording %s %s: %s", req.Method, req.URL, err)
+	}
+
+	return resp, nil
+}
+
+var (
+	vcrNamesMu sync.Mutex
+	vcrNames   = map[string]int{}
+)
+
+// vcrName returns a name of length lowercase letters that is the same every
+// time a test runs, so that the requests using it can be replayed. The names
+// depend on the test calling vcrName and on how many names it got before.
+func vcrName(length int) string {
+	test := vcrCallingTest()
+
+	vcrNamesMu.Lock()
+	n := vcrNames[test]
+	vcrNames[test]++
+	vcrNamesMu.Unlock()
+
+	name := make([]byte, 0, length)
+	for i := 0; len(name) < length; i++ {
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%d/%d", test, n, i)))
+		for _, b := range sum {
+			if len(name) == length {
+				break
+			}
+			name = append(name, 'a'+b%26)
+		}
+	}
+
+	return string(name)
+}
+
+// vcrCallingTest returns the name of the test function in the call stack, or
+// an empty string when called outside of a test.
+func vcrCallingTest() string {
+	pc := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(2, pc)])
+	for {
+		frame, more := frames.Next()
+		if i := strings.LastIndex(frame.Function, ".Test"); i >= 0 {
+			return frame.Function[i+1:]
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+func TestVCRTransport(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"request":%d,"body":%q}`, n, body)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	send := func(client *http.Client, body string) (string, error) {
+		resp, err := client.Post(server.URL+"/v2/droplets", "application/json", strings.NewReader(body))
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		b, err := ioutil.ReadAll(resp.Body)
+		return string(b), err
+	}
+
+	recorder, err := newVCRCassette(vcrModeRecord, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client := &http.Client{Transport: recorder.transport(http.DefaultTransport)}
+
+	var recorded []string
+	for _, body := range []string{"foo", "foo", "bar"} {
+		resp, err := send(client, body)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		recorded = append(recorded, resp)
+	}
+
+	player, err := newVCRCassette(vcrModeReplay, path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client = &http.Client{Transport: player.transport(http.DefaultTransport)}
+
+	// The last interaction recorded for a request is replayed once the
+	// others have been.
+	for i, c := range []struct {
+		body     string
+		expected string
+	}{
+		{body: "bar", expected: recorded[2]},
+		{body: "foo", expected: recorded[0]},
+		{body: "foo", expected: recorded[1]},
+		{body: "foo", expected: recorded[1]},
+	} {
+		resp, err := send(client, c.body)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if resp != c.expected {
+			t.Errorf("expected request %d to be replayed as %s, got %s", i, c.expected, resp)
+		}
+	}
+
+	if requests != 3 {
+		t.Errorf("expected 3 requests to reach the server, got %d", requests)
+	}
+
+	if _, err := send(client, "baz"); err == nil || !strings.Contains(err.Error(), "no interaction recorded") {
+		t.Errorf("expected an error for a request that was not recorded, got %v", err)
+	}
+}
+
+func TestVCRName(t *testing.T) {
+	vcrNamesMu.Lock()
+	delete(vcrNames, "TestVCRName")
+	vcrNamesMu.Unlock()
+
+	first, second := vcrName(10), vcrName(10)
+	if len(first) != 10 || strings.Trim(first, "abcdefghijklmnopqrstuvwxyz") != "" {
+		t.Errorf("expected 10 lowercase letters, got %q", first)
+	}
+	if first == second {
+		t.Errorf("expected the names of a test to be different, got %q twice", first)
+	}
+
+	vcrNamesMu.Lock()
+	delete(vcrNames, "TestVCRName")
+	vcrNamesMu.Unlock()
+
+	if again := vcrName(10); again != first {
+		t.Errorf("expected the names to be the same on every run, got %q and %q", first, again)
+	}
+}
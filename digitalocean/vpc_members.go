@@ -0,0 +1,100 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func vpcMembersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"urn": {
+			Type:        schema.TypeString,
+			Description: "the uniform resource name of the member",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the member",
+		},
+		"resource_type": {
+			Type:        schema.TypeString,
+			Description: "type of the member, e.g. droplet, loadbalancer, kubernetes or dbaas",
+		},
+		"resource_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the member",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the date and time when the member was created",
+		},
+	}
+}
+
+func getDigitalOceanVPCMembers(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*CombinedConfig).godoClient()
+
+	vpcID, ok := extra["vpc_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `vpc_id` key from query data")
+	}
+
+	var allMembers []interface{}
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	for {
+		members, resp, err := client.VPCs.ListMembers(context.Background(), vpcID, nil, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving VPC members: %s", err)
+		}
+
+		for _, member := range members {
+			allMembers = append(allMembers, member)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving VPC members: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return allMembers, nil
+}
+
+func flattenDigitalOceanVPCMember(rawMember interface{}, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	member, ok := rawMember.(*godo.VPCMember)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert to *godo.VPCMember")
+	}
+
+	// Member URNs take the form do:<resource type>:<resource id>.
+	var resourceType, resourceID string
+	if parts := strings.SplitN(member.URN, ":", 3); len(parts) == 3 {
+		resourceType = parts[1]
+		resourceID = parts[2]
+	}
+
+	flattenedMember := map[string]interface{}{
+		"urn":           member.URN,
+		"name":          member.Name,
+		"resource_type": resourceType,
+		"resource_id":   resourceID,
+		"created_at":    member.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	return flattenedMember, nil
+}
@@ -0,0 +1,382 @@
+package digitalocean
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+)
+
+// zoneFileDefaultTTL is used for records of a zone file which neither set a
+// TTL nor inherit one from a $TTL directive.
+const zoneFileDefaultTTL = 1800
+
+// zoneFileRecord is a record parsed from a zone file, ready to be created in a
+// DigitalOcean domain.
+type zoneFileRecord struct {
+	godo.DomainRecordEditRequest
+}
+
+// key identifies a record by all of its fields so that records parsed from
+// two versions of a zone file can be matched.
+func (r zoneFileRecord) key() string {
+	return fmt.Sprintf("%s/%s/%s/%d/%d/%d/%d/%d/%s",
+		r.Type, strings.ToLower(r.Name), r.Data, r.TTL, r.Priority, r.Port, r.Weight, r.Flags, r.Tag)
+}
+
+// zoneFileLine is a logical line of a zone file: parentheses are resolved and
+// comments and quotes are stripped.
+type zoneFileLine struct {
+	number      int
+	tokens      []string
+	inheritName bool
+}
+
+// parseZoneFile parses a BIND zone file for domain. It returns the records
+// which can be created in a DigitalOcean domain and a description of each
+// record which can not. SOA and apex NS records are skipped as they are
+// managed by DigitalOcean.
+func parseZoneFile(domain string, contents string) ([]zoneFileRecord, []string, error) {
+	lines, err := tokenizeZoneFile(contents)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	origin := strings.ToLower(strings.TrimSuffix(domain, ".")) + "."
+	ttl := zoneFileDefaultTTL
+	previousName := "@"
+
+	var records []zoneFileRecord
+	var unsupported []string
+	seen := make(map[string]bool)
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) < 2 {
+				return nil, nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", line.number)
+			}
+			origin = strings.ToLower(zoneFileAbsoluteName(tokens[1], origin))
+			continue
+		case "$TTL":
+			if len(tokens) < 2 {
+				return nil, nil, fmt.Errorf("line %d: $TTL requires a value", line.number)
+			}
+			ttl, err = parseZoneFileTTL(tokens[1])
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, nil, fmt.Errorf("line %d: %s directives are not supported", line.number, tokens[0])
+		}
+
+		name := previousName
+		if !line.inheritName {
+			name, err = zoneFileRelativeName(tokens[0], origin, domain)
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			tokens = tokens[1:]
+		}
+		previousName = name
+
+		recordTTL := ttl
+		recordType := ""
+		for len(tokens) > 0 && recordType == "" {
+			token := strings.ToUpper(tokens[0])
+			switch {
+			case token == "IN" || token == "CH" || token == "HS":
+			case token != "" && token[0] >= '0' && token[0] <= '9':
+				recordTTL, err = parseZoneFileTTL(token)
+				if err != nil {
+					return nil, nil, fmt.Errorf("line %d: %s", line.number, err)
+				}
+			default:
+				recordType = token
+			}
+			tokens = tokens[1:]
+		}
+		if recordType == "" {
+			return nil, nil, fmt.Errorf("line %d: missing record type", line.number)
+		}
+
+		if recordType == "SOA" || (recordType == "NS" && name == "@") {
+			continue
+		}
+
+		record := zoneFileRecord{
+			DomainRecordEditRequest: godo.DomainRecordEditRequest{
+				Type: recordType,
+				Name: name,
+				TTL:  recordTTL,
+			},
+		}
+
+		rdata := tokens
+		switch recordType {
+		case "A", "AAAA":
+			err = zoneFileExpectFields(rdata, 1)
+			if err == nil {
+				record.Data = rdata[0]
+			}
+		case "CNAME", "NS":
+			err = zoneFileExpectFields(rdata, 1)
+			if err == nil {
+				record.Data = zoneFileTarget(rdata[0], origin, domain)
+			}
+		case "MX":
+			err = zoneFileExpectFields(rdata, 2)
+			if err == nil {
+				record.Priority, err = strconv.Atoi(rdata[0])
+				record.Data = zoneFileTarget(rdata[1], origin, domain)
+			}
+		case "SRV":
+			err = zoneFileExpectFields(rdata, 4)
+			if err == nil {
+				record.Priority, err = strconv.Atoi(rdata[0])
+				if err == nil {
+					record.Weight, err = strconv.Atoi(rdata[1])
+				}
+				if err == nil {
+					record.Port, err = strconv.Atoi(rdata[2])
+				}
+				record.Data = zoneFileTarget(rdata[3], origin, domain)
+			}
+		case "TXT":
+			if len(rdata) == 0 {
+				err = fmt.Errorf("expected at least 1 field")
+			}
+			record.Data = zoneFileTXTValue(rdata)
+		case "CAA":
+			err = zoneFileExpectFields(rdata, 3)
+			if err == nil {
+				record.Flags, err = strconv.Atoi(rdata[0])
+				record.Tag = strings.ToLower(rdata[1])
+				record.Data = rdata[2]
+			}
+		default:
+			unsupported = append(unsupported, fmt.Sprintf("line %d: %s %s", line.number, name, recordType))
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: invalid %s record: %s", line.number, recordType, err)
+		}
+
+		if seen[record.key()] {
+			continue
+		}
+		seen[record.key()] = true
+
+		records = append(records, record)
+	}
+
+	return records, unsupported, nil
+}
+
+// tokenizeZoneFile splits a zone file in logical lines, joining lines wrapped
+// in parentheses.
+func tokenizeZoneFile(contents string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var current *zoneFileLine
+	var token strings.Builder
+	inToken, inQuotes, escaped := false, false, false
+	depth := 0
+	lineNumber := 1
+	startOfLine := true
+
+	flush := func() {
+		if !inToken {
+			return
+		}
+		if current == nil {
+			current = &zoneFileLine{number: lineNumber}
+		}
+		current.tokens = append(current.tokens, token.String())
+		token.Reset()
+		inToken = false
+	}
+
+	endLine := func() {
+		flush()
+		if current != nil && len(current.tokens) > 0 {
+			lines = append(lines, *current)
+		}
+		current = nil
+		startOfLine = true
+	}
+
+	for i := 0; i < len(contents); i++ {
+		c := contents[i]
+
+		if inQuotes {
+			switch {
+			case escaped:
+				token.WriteByte(c)
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inQuotes = false
+				flush()
+			default:
+				if c == '\n' {
+					lineNumber++
+				}
+				token.WriteByte(c)
+			}
+			continue
+		}
+
+		switch c {
+		case ';':
+			for i < len(contents) && contents[i] != '\n' {
+				i++
+			}
+			i--
+		case '"':
+			flush()
+			if current == nil {
+				current = &zoneFileLine{number: lineNumber, inheritName: !startOfLine}
+			}
+			inQuotes = true
+			inToken = true
+			startOfLine = false
+		case '(':
+			flush()
+			depth++
+		case ')':
+			flush()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNumber)
+			}
+			depth--
+		case '\n':
+			if depth == 0 {
+				endLine()
+			} else {
+				flush()
+			}
+			lineNumber++
+		case ' ', '\t', '\r':
+			flush()
+			if current == nil && startOfLine {
+				startOfLine = false
+				current = &zoneFileLine{number: lineNumber, inheritName: true}
+			}
+		default:
+			if current == nil {
+				current = &zoneFileLine{number: lineNumber}
+			}
+			startOfLine = false
+			token.WriteByte(c)
+			inToken = true
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNumber)
+	}
+	endLine()
+
+	return lines, nil
+}
+
+// zoneFileTXTValue returns the value of a TXT record made of strings as
+// expected by the digitalocean_record resource: a single string is used as is,
+// multiple strings are kept apart as quoted strings, e.g. "v=DKIM1; " "p=MIIB".
+func zoneFileTXTValue(strs []string) string {
+	if len(strs) == 1 {
+		return strs[0]
+	}
+
+	quoted := make([]string, len(strs))
+	for i, str := range strs {
+		str = strings.ReplaceAll(str, `\`, `\\`)
+		quoted[i] = `"` + strings.ReplaceAll(str, `"`, `\"`) + `"`
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+func zoneFileExpectFields(rdata []string, n int) error {
+	if len(rdata) != n {
+		return fmt.Errorf("expected %d fields, found %d", n, len(rdata))
+	}
+
+	return nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds or using the BIND units, e.g. 1h30m.
+func parseZoneFileTTL(value string) (int, error) {
+	if ttl, err := strconv.Atoi(value); err == nil {
+		return ttl, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	ttl, number := 0, ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+
+		multiplier, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		n, _ := strconv.Atoi(number)
+		ttl += n * multiplier
+		number = ""
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+
+	return ttl, nil
+}
+
+func zoneFileAbsoluteName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "." + origin
+}
+
+// zoneFileRelativeName converts an owner name to a record name relative to
+// the domain, using @ for the apex.
+func zoneFileRelativeName(name, origin, domain string) (string, error) {
+	absolute := strings.ToLower(zoneFileAbsoluteName(name, origin))
+	apex := strings.ToLower(strings.TrimSuffix(domain, ".")) + "."
+
+	if absolute == apex {
+		return "@", nil
+	}
+	if !strings.HasSuffix(absolute, "."+apex) {
+		return "", fmt.Errorf("record %s is outside of the domain %s", name, domain)
+	}
+
+	return strings.TrimSuffix(absolute, "."+apex), nil
+}
+
+// zoneFileTarget converts a target name to the form expected by the API: @
+// for the apex and fully qualified names otherwise.
+func zoneFileTarget(name, origin, domain string) string {
+	absolute := zoneFileAbsoluteName(name, origin)
+	if strings.EqualFold(absolute, strings.TrimSuffix(domain, ".")+".") {
+		return "@"
+	}
+
+	return absolute
+}
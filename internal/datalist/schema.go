@@ -30,6 +30,10 @@ type ResourceConfig struct {
 
 	// Extra parameters to expose on the datasource alongside `filter` and `sort`.
 	ExtraQuerySchema map[string]*schema.Schema
+
+	// Optional. Given the flattened records left once filtered and sorted, return
+	// the values of the computed attributes of ExtraQuerySchema summarizing them.
+	SummarizeRecords func(records []map[string]interface{}) (map[string]interface{}, error)
 }
 
 // Returns a new "data list" resource given the specified configuration. This
@@ -123,6 +127,18 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 			return diag.Errorf("unable to set `%s` attribute: %s", config.ResultAttributeName, err)
 		}
 
+		if config.SummarizeRecords != nil {
+			summary, err := config.SummarizeRecords(flattenedRecords)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for key, value := range summary {
+				if err := d.Set(key, value); err != nil {
+					return diag.Errorf("unable to set `%s` attribute: %s", key, err)
+				}
+			}
+		}
+
 		return nil
 	}
 }
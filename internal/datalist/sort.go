@@ -54,7 +54,9 @@ func expandSorts(rawSorts []interface{}) []commonSort {
 }
 
 func applySorts(recordSchema map[string]*schema.Schema, records []map[string]interface{}, sorts []commonSort) []map[string]interface{} {
-	sort.Slice(records, func(_i, _j int) bool {
+	// The sort is stable so that the records comparing equal keep the
+	// order they were returned in, and the results are the same every time.
+	sort.SliceStable(records, func(_i, _j int) bool {
 		for _, s := range sorts {
 			// Handle multiple sorts by applying them in order
 			i := _i
@@ -73,7 +75,7 @@ func applySorts(recordSchema map[string]*schema.Schema, records []map[string]int
 			}
 		}
 
-		return true
+		return false
 	})
 
 	return records
@@ -173,3 +173,18 @@ func TestApplySortsMultiple(t *testing.T) {
 	}
 
 }
+
+func TestApplySortsStable(t *testing.T) {
+	testData := sizesTestDataForSorts()
+	for _, record := range testData {
+		record["memory"] = 1024
+	}
+
+	// The sizes have the same memory, they keep their order.
+	sizes := applySorts(sizesTestSchema(), testData, []commonSort{{"memory", "desc"}})
+	if sizes[0]["slug"] != "s-1vcpu-1gb" ||
+		sizes[1]["slug"] != "s-2vcpu-2gb" ||
+		sizes[2]["slug"] != "s-4vcpu-8gb" {
+		t.Fatalf("Expecting sizes comparing equal to keep their order")
+	}
+}